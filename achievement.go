@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -9,7 +10,17 @@ import (
 )
 
 // GetAchievementUnlocks gets a list of users who have earned an achievement.
+//
+// GetAchievementUnlocks uses context.Background internally; to specify the context, use GetAchievementUnlocksContext.
 func (c *Client) GetAchievementUnlocks(params models.GetAchievementUnlocksParameters) (*models.GetAchievementUnlocks, error) {
+	return c.GetAchievementUnlocksContext(context.Background(), params)
+}
+
+// GetAchievementUnlocksContext gets a list of users who have earned an achievement.
+func (c *Client) GetAchievementUnlocksContext(ctx context.Context, params models.GetAchievementUnlocksParameters) (*models.GetAchievementUnlocks, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -23,7 +34,14 @@ func (c *Client) GetAchievementUnlocks(params models.GetAchievementUnlocksParame
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	if params.Hardcore != nil {
+		h := 0
+		if *params.Hardcore {
+			h = 1
+		}
+		details = append(details, raHttp.H(h))
+	}
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -48,7 +48,7 @@ func TestGetAchievementUnlocks(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetAchievementUnlocks, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementUnlocks.php?a=14402&c=10&o=10&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementUnlocks.php?a=14402&c=10&o=10&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -188,3 +188,36 @@ func TestGetAchievementUnlocks(tt *testing.T) {
 		})
 	}
 }
+
+func TestGetAchievementUnlocksQuery(tt *testing.T) {
+	hardcore, softcore := true, false
+	tests := []struct {
+		name     string
+		params   models.GetAchievementUnlocksParameters
+		expected string
+	}{
+		{
+			name:     "required only",
+			params:   models.GetAchievementUnlocksParameters{AchievementID: 14402},
+			expected: "a=14402",
+		},
+		{
+			name:     "hardcore only",
+			params:   models.GetAchievementUnlocksParameters{AchievementID: 14402, Hardcore: &hardcore},
+			expected: "a=14402&h=1",
+		},
+		{
+			name:     "all unlocks",
+			params:   models.GetAchievementUnlocksParameters{AchievementID: 14402, Hardcore: &softcore},
+			expected: "a=14402&h=0",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, capturedQuery(t, func(client *retroachievements.Client) error {
+				_, err := client.GetAchievementUnlocks(test.params)
+				return err
+			}))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package retroachievements
+
+import (
+	"context"
+	"sync"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// DefaultBatchWorkers is the number of concurrent calls made by batch helpers when no worker limit is given
+const DefaultBatchWorkers = 4
+
+// BatchResult holds the outcome of a single call made as part of a batch
+type BatchResult[R any] struct {
+	// Decoded response of the call, the zero value if it failed
+	Result R
+
+	// Error returned by the call, if any
+	Err error
+}
+
+// Batch calls fn for every parameter with at most workers calls running at the same time, returning
+// the results in the same order as the parameters. A failed call does not stop the others, and once
+// ctx is done the calls not started yet fail with its error. Calls go through the client, so they
+// also wait on any rate limiter it has.
+func Batch[P any, R any](ctx context.Context, params []P, workers int, fn func(ctx context.Context, params P) (R, error)) []BatchResult[R] {
+	if workers < 1 {
+		workers = DefaultBatchWorkers
+	}
+	workers = min(workers, len(params))
+	results := make([]BatchResult[R], len(params))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					results[i].Err = err
+					continue
+				}
+				results[i].Result, results[i].Err = fn(ctx, params[i])
+			}
+		}()
+	}
+	for i := range params {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// GetGameBatch gets basic metadata about several games, running at most workers calls at the same time.
+func (c *Client) GetGameBatch(ctx context.Context, params []models.GetGameParameters, workers int) []BatchResult[*models.GetGame] {
+	return Batch(ctx, params, workers, c.GetGameContext)
+}
+
+// GetGameExtendedBatch gets extended metadata about several games, running at most workers calls at the same time.
+func (c *Client) GetGameExtendedBatch(ctx context.Context, params []models.GetGameExtentedParameters, workers int) []BatchResult[*models.GetGameExtented] {
+	return Batch(ctx, params, workers, c.GetGameExtendedContext)
+}
+
+// GetUserSummaryBatch gets the profile metadata of several users, running at most workers calls at the same time.
+func (c *Client) GetUserSummaryBatch(ctx context.Context, params []models.GetUserSummaryParameters, workers int) []BatchResult[*models.GetUserSummary] {
+	return Batch(ctx, params, workers, c.GetUserSummaryContext)
+}
+
+// GetUserProgressBatch gets the progress of several users on lists of games, running at most workers calls at the same time.
+func (c *Client) GetUserProgressBatch(ctx context.Context, params []models.GetUserProgressParameters, workers int) []BatchResult[*map[string]models.GetUserProgress] {
+	return Batch(ctx, params, workers, c.GetUserProgressContext)
+}
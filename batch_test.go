@@ -0,0 +1,82 @@
+package retroachievements_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGameBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		id := r.URL.Query().Get("i")
+		if id == "3" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"Title":"Game %s"}`, id)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	params := []models.GetGameParameters{}
+	for i := range 8 {
+		params = append(params, models.GetGameParameters{GameID: i + 1})
+	}
+	results := client.GetGameBatch(context.Background(), params, 2)
+	require.Len(t, results, 8)
+	for i, result := range results {
+		if i == 2 {
+			require.Nil(t, result.Result)
+			require.ErrorIs(t, result.Err, retroachievements.ErrServer)
+			continue
+		}
+		require.NoError(t, result.Err)
+		require.Equal(t, fmt.Sprintf("Game %d", i+1), result.Result.Title)
+	}
+	require.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestBatchContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	results := retroachievements.Batch(ctx, []int{1, 2, 3}, 1, func(ctx context.Context, n int) (int, error) {
+		calls++
+		cancel()
+		return n * 2, nil
+	})
+	require.Equal(t, 1, calls)
+	require.Equal(t, []retroachievements.BatchResult[int]{
+		{Result: 2},
+		{Err: context.Canceled},
+		{Err: context.Canceled},
+	}, results)
+}
+
+func TestBatchEmpty(t *testing.T) {
+	results := retroachievements.Batch(context.Background(), []int{}, 0, func(ctx context.Context, n int) (int, error) {
+		return n, nil
+	})
+	require.Empty(t, results)
+}
@@ -0,0 +1,87 @@
+package retroachievements
+
+import (
+	"context"
+	"time"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// Cache stores raw response bodies for successful requests, implementations must be safe for concurrent use
+type Cache interface {
+	// Get returns the stored body for a key, false if it is missing or expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores a body under a key for the given amount of time
+	Set(key string, data []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTLs holds how long responses are cached for each endpoint path. Caching is opt-in per endpoint,
+// paths missing here and from CacheTTLs are never cached, which keeps Connect calls changing state out of the cache.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"/API/API_GetGame.php":                   24 * time.Hour,
+	"/API/API_GetGameExtended.php":           24 * time.Hour,
+	"/API/API_GetGameHashes.php":             24 * time.Hour,
+	"/API/API_GetConsoleIDs.php":             24 * time.Hour,
+	"/API/API_GetGameList.php":               24 * time.Hour,
+	"/API/API_GetAchievementCount.php":       time.Hour,
+	"/API/API_GetGameLeaderboards.php":       time.Hour,
+	"/API/API_GetUserRecentAchievements.php": 30 * time.Second,
+}
+
+// ResponseCache makes the client store successful responses in the cache and serve repeated requests from it
+func ResponseCache(cache Cache) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.Cache = cache
+	})
+}
+
+// CacheTTL overrides how long responses are cached for an endpoint path, a zero ttl disables caching for it
+func CacheTTL(path string, ttl time.Duration) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		if c.CacheTTLs == nil {
+			c.CacheTTLs = map[string]time.Duration{}
+		}
+		c.CacheTTLs[path] = ttl
+	})
+}
+
+// CacheMode controls how a single call uses the client cache
+type CacheMode int
+
+const (
+	// CacheDefault serves the call from the cache when possible and stores the response
+	CacheDefault CacheMode = iota
+
+	// CacheBypass neither reads nor writes the cache
+	CacheBypass
+
+	// CacheRefresh always calls the API and stores the fresh response
+	CacheRefresh
+)
+
+type cacheModeKey struct{}
+
+// WithCacheMode returns a context that makes calls use the cache according to mode
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+func cacheMode(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(CacheMode)
+	return mode
+}
+
+func (c *Client) cacheTTL(path string) time.Duration {
+	if ttl, ok := c.CacheTTLs[path]; ok {
+		return ttl
+	}
+	return DefaultCacheTTLs[path]
+}
+
+// cacheKey identifies a request by host, path and query parameters, leaving out the API key
+func cacheKey(r *raHttp.Request) string {
+	q := r.Values()
+	q.Del("y")
+	return r.Host + r.Path + "?" + q.Encode()
+}
@@ -0,0 +1,171 @@
+package retroachievements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	cache := retroachievements.NewMemoryCache(2)
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	cache.Set("c", []byte("3"), time.Minute)
+	require.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+	data, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, []byte("1"), data)
+	cache.Set("d", []byte("4"), -time.Second)
+	_, ok = cache.Get("d")
+	require.False(t, ok)
+}
+
+func TestFileCache(t *testing.T) {
+	cache, err := retroachievements.NewFileCache(t.TempDir())
+	require.NoError(t, err)
+	_, ok := cache.Get("a")
+	require.False(t, ok)
+	cache.Set("a", []byte(`{"ID":1}`), time.Minute)
+	data, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, []byte(`{"ID":1}`), data)
+	cache.Set("b", []byte("2"), -time.Second)
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+}
+
+func TestResponseCache(tt *testing.T) {
+	tests := []struct {
+		name          string
+		details       []retroachievements.ClientDetail
+		mode          retroachievements.CacheMode
+		call          func(client *retroachievements.Client, ctx context.Context, secret string) error
+		expectedCalls int32
+	}{
+		{
+			name: "repeated call served from cache",
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+				return err
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "api key not part of the key",
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				client.APISecret = secret
+				_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+				return err
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "bypass cache",
+			mode: retroachievements.CacheBypass,
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "refresh cache",
+			mode: retroachievements.CacheRefresh,
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "unlisted endpoint not cached",
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := retroachievements.Call[models.GetGame](ctx, client, "/API/API_GetNewEndpoint.php", map[string]string{"i": "1"})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "connect call not cached",
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := retroachievements.Call[models.GetGame](ctx, client, "/dorequest.php", map[string]string{"r": "awardachievement", "a": "1"})
+				return err
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "caching enabled for unlisted endpoint",
+			details: []retroachievements.ClientDetail{
+				retroachievements.CacheTTL("/API/API_GetNewEndpoint.php", time.Minute),
+			},
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := retroachievements.Call[models.GetGame](ctx, client, "/API/API_GetNewEndpoint.php", map[string]string{"i": "1"})
+				return err
+			},
+			expectedCalls: 1,
+		},
+		{
+			name: "caching disabled for endpoint",
+			details: []retroachievements.ClientDetail{
+				retroachievements.CacheTTL("/API/API_GetGame.php", 0),
+			},
+			call: func(client *retroachievements.Client, ctx context.Context, secret string) error {
+				_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+				return err
+			},
+			expectedCalls: 2,
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+			cache := retroachievements.NewMemoryCache(10)
+			details := append([]retroachievements.ClientDetail{retroachievements.ResponseCache(cache)}, test.details...)
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      server.URL,
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			}, details...)
+			require.NoError(t, test.call(client, context.Background(), "some_secret"))
+			ctx := retroachievements.WithCacheMode(context.Background(), test.mode)
+			require.NoError(t, test.call(client, ctx, "some_other_secret"))
+			require.Equal(t, test.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestResponseCacheSkipsErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.ResponseCache(retroachievements.NewMemoryCache(10)))
+	for range 2 {
+		_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+		require.ErrorIs(t, err, retroachievements.ErrServer)
+	}
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,91 @@
+package retroachievements
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// call sends a request for an endpoint not wrapped by the client. The request is a GET sent with the user agent
+// unless details say otherwise. Web API paths are sent with the API key the same way the wrapped endpoints are,
+// and Connect requests needing authentication are sent with the session token unless details already hold one.
+func (c *Client) call(ctx context.Context, details ...raHttp.RequestDetail) (*raHttp.Response, error) {
+	details = append([]raHttp.RequestDetail{
+		raHttp.Method(http.MethodGet),
+		raHttp.UserAgent(c.UserAgent),
+	}, details...)
+	r := raHttp.NewRequest(c.Host, details...)
+	values := r.Values()
+	if strings.HasPrefix(r.Path, "/API/") && !values.Has("y") {
+		details = append(details, raHttp.Y(c.APISecret))
+	}
+	kind := cmp.Or(values.Get("r"), r.Form.Get("r"))
+	if strings.HasSuffix(r.Path, "/dorequest.php") && !connectAnonymous[kind] && !values.Has("t") && !r.Form.Has("t") && c.hasConnectSession(ctx) {
+		return c.doConnect(ctx, details...)
+	}
+	return c.do(ctx, details...)
+}
+
+// callDetails describes a GET request to path with params as its query parameters
+func callDetails(path string, params map[string]string) []raHttp.RequestDetail {
+	details := []raHttp.RequestDetail{
+		raHttp.Path(path),
+	}
+	for k, v := range params {
+		details = append(details, raHttp.Param(k, v))
+	}
+	return details
+}
+
+// Call gets an endpoint returning a single object and decodes it into T, use it for endpoints the client does
+// not wrap yet. The request goes through the same authentication, caching, retries and error handling as the
+// wrapped endpoints, and Connect requests are authenticated with the session token, for example:
+//
+//	game, err := retroachievements.Call[models.GetGame](ctx, client, "/API/API_GetGame.php", map[string]string{"i": "1"})
+func Call[T any](ctx context.Context, c *Client, path string, params map[string]string) (*T, error) {
+	return Send[T](ctx, c, callDetails(path, params)...)
+}
+
+// CallList gets an endpoint returning a list and decodes it into a slice of T, use it for endpoints the client does not wrap yet.
+func CallList[T any](ctx context.Context, c *Client, path string, params map[string]string) ([]T, error) {
+	return SendList[T](ctx, c, callDetails(path, params)...)
+}
+
+// Send makes the request described by details and decodes the single object it returns into T. It is Call for
+// requests needing more than a map of query parameters, such as repeated query values, form posts and uploads.
+// The request is a GET unless details set another method, for example:
+//
+//	resp, err := retroachievements.Send[Upload](ctx, client,
+//		raHttp.Method(http.MethodPost),
+//		raHttp.Path("/dorequest.php"),
+//		raHttp.FormValue("r", "uploadbadgeimage"),
+//		raHttp.FilePart(raHttp.Part{Field: "file", FileName: "badge.png", ContentType: "image/png", Data: data}),
+//	)
+func Send[T any](ctx context.Context, c *Client, details ...raHttp.RequestDetail) (*T, error) {
+	r, err := c.call(ctx, details...)
+	if err != nil {
+		return nil, fmt.Errorf("calling endpoint: %w", err)
+	}
+	resp, err := raHttp.ResponseObject[T](r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response object: %w", err)
+	}
+	return resp, nil
+}
+
+// SendList makes the request described by details and decodes the list it returns into a slice of T.
+func SendList[T any](ctx context.Context, c *Client, details ...raHttp.RequestDetail) ([]T, error) {
+	r, err := c.call(ctx, details...)
+	if err != nil {
+		return nil, fmt.Errorf("calling endpoint: %w", err)
+	}
+	resp, err := raHttp.ResponseList[T](r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response list: %w", err)
+	}
+	return resp, nil
+}
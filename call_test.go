@@ -0,0 +1,143 @@
+package retroachievements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+type gameProgression struct {
+	ID               int `json:"ID"`
+	MedianTimeToBeat int `json:"MedianTimeToBeat"`
+}
+
+func TestCall(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		require.Equal(t, "/API/API_GetGameProgression.php", r.URL.Path)
+		require.Equal(t, "i=228&y=some_secret", r.URL.RawQuery)
+		require.Equal(t, "go-retroachievements/v0.0.0", r.UserAgent())
+		_, err := w.Write([]byte(`{"ID":228,"MedianTimeToBeat":17878}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.ResponseCache(retroachievements.NewMemoryCache(10)), retroachievements.CacheTTL("/API/API_GetGameProgression.php", time.Minute))
+	for range 2 {
+		resp, err := retroachievements.Call[gameProgression](context.Background(), client, "/API/API_GetGameProgression.php", map[string]string{
+			"i": "228",
+		})
+		require.NoError(t, err)
+		require.Equal(t, &gameProgression{ID: 228, MedianTimeToBeat: 17878}, resp)
+	}
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestCallError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	resp, err := retroachievements.Call[gameProgression](context.Background(), client, "/API/API_GetGameProgression.php", nil)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+	require.EqualError(t, err, "parsing response object: error code 401 returned: ")
+
+	list, err := retroachievements.CallList[models.GetTopTenUsers](context.Background(), client, "/API/API_GetTopTenUsers.php", nil)
+	require.Nil(t, list)
+	require.EqualError(t, err, "parsing response list: error code 401 returned: ")
+}
+
+func TestCallList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/dorequest.php", r.URL.Path)
+		require.Equal(t, "g=1&r=hashlibrary", r.URL.RawQuery)
+		_, err := w.Write([]byte(`[{"1":"jamiras","2":10,"3":20}]`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	list, err := retroachievements.CallList[models.GetTopTenUsers](context.Background(), client, "/dorequest.php", map[string]string{
+		"r": "hashlibrary",
+		"g": "1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []models.GetTopTenUsers{{Username: "jamiras", HarcordPoints: 10, RetroPoints: 20}}, list)
+}
+
+func TestSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch {
+		case r.URL.Path == "/API/API_GetGameProgression.php":
+			require.Equal(t, http.MethodGet, r.Method)
+			require.Equal(t, "i=228&i=229&y=some_secret", r.URL.RawQuery)
+			_, err := w.Write([]byte(`[{"ID":228},{"ID":229}]`))
+			require.NoError(t, err)
+		case r.Form.Get("r") == "login2":
+			_, err := w.Write([]byte(`{"Success":true,"User":"jamiras","Token":"session_token"}`))
+			require.NoError(t, err)
+		default:
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "/dorequest.php", r.URL.Path)
+			require.Equal(t, "jamiras", r.URL.Query().Get("u"))
+			require.Equal(t, "session_token", r.URL.Query().Get("t"))
+			require.Equal(t, "awardachievement", r.PostForm.Get("r"))
+			require.Equal(t, []string{"1", "2"}, r.PostForm["a"])
+			_, err := w.Write([]byte(`{"Success":true,"Score":10}`))
+			require.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+		ConnectConfig: &retroachievements.ClientConnectConfig{
+			ConnectUsername: "jamiras",
+			ConnectSecret:   "hunter2",
+		},
+	})
+
+	list, err := retroachievements.SendList[gameProgression](context.Background(), client,
+		raHttp.Path("/API/API_GetGameProgression.php"),
+		raHttp.QueryValues(url.Values{"i": {"228", "229"}}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []gameProgression{{ID: 228}, {ID: 229}}, list)
+
+	type award struct {
+		Success bool
+		Score   int
+	}
+	resp, err := retroachievements.Send[award](context.Background(), client,
+		raHttp.Method(http.MethodPost),
+		raHttp.Path("/dorequest.php"),
+		raHttp.FormValue("r", "awardachievement"),
+		raHttp.FormValues(url.Values{"a": {"1", "2"}}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, &award{Success: true, Score: 10}, resp)
+}
@@ -2,17 +2,25 @@
 package retroachievements
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	raHttp "github.com/joshraphael/go-retroachievements/http"
 )
 
 const (
 	RetroAchievementHost = "https://retroachievements.org"
+
+	// DefaultMaxResponseSize is the largest response body accepted when no maximum size is set
+	DefaultMaxResponseSize = 64 << 20
 )
 
 type ClientConfig struct {
@@ -20,11 +28,18 @@ type ClientConfig struct {
 	UserAgent     string
 	APISecret     string
 	ConnectConfig *ClientConnectConfig
+
+	// Logger receives structured events for every request, nil disables logging
+	Logger *slog.Logger
 }
 
 type ClientConnectConfig struct {
+	// Password used to log into the Connect API, and to log in again when the session token expires
 	ConnectSecret   string
 	ConnectUsername string
+
+	// Session token from an earlier login, used until the API rejects it
+	ConnectToken string
 }
 
 type Client struct {
@@ -34,6 +49,43 @@ type Client struct {
 	ConnectSecret   string
 	ConnectUsername string
 	HttpClient      *http.Client
+
+	// StrictNotFound makes endpoints returning a single object fail with ErrNotFound when the resource does not exist
+	StrictNotFound bool
+
+	// RateLimiter is waited on before every request is sent
+	RateLimiter Limiter
+
+	// RetryPolicy decides how failed requests are retried, nil disables retries
+	RetryPolicy *RetryPolicy
+
+	// Cache stores successful responses, nil disables caching
+	Cache Cache
+
+	// CacheTTLs overrides DefaultCacheTTLs for each endpoint path
+	CacheTTLs map[string]time.Duration
+
+	// Middlewares wrap every request, the first one being the outermost
+	Middlewares []Middleware
+
+	// Logger receives structured events for every request, nil disables logging
+	Logger *slog.Logger
+
+	// LogBodyLimit is the number of response body bytes included in debug logs, zero leaves the body out
+	LogBodyLimit int
+
+	// MaxResponseSize is the largest response body accepted in bytes, zero uses DefaultMaxResponseSize
+	MaxResponseSize int64
+
+	// Metrics receives measurements for every call, nil disables metrics
+	Metrics Metrics
+
+	// Credentials provides the API key for every request in place of APISecret when set
+	Credentials CredentialsProvider
+
+	coalescer *coalescer
+
+	session connectSession
 }
 
 type ClientDetail interface {
@@ -59,6 +111,29 @@ func HttpClient(httpClient *http.Client) ClientDetail {
 	})
 }
 
+// RateLimit makes every request wait on the limiter before being sent, pass the same limiter
+// to several clients to share one budget between them
+func RateLimit(limiter Limiter) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.RateLimiter = limiter
+	})
+}
+
+// MaxResponseSize fails requests with ErrResponseTooLarge when the response body is bigger than size bytes
+func MaxResponseSize(size int64) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.MaxResponseSize = size
+	})
+}
+
+// NotFoundErrors makes endpoints returning a single object fail with ErrNotFound instead of returning a nil object
+// when the requested resource does not exist
+func NotFoundErrors() ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.StrictNotFound = true
+	})
+}
+
 var version = sync.OnceValue(func() string {
 	libraryVersion := "v0.0.0"
 	buildInfo, ok := debug.ReadBuildInfo()
@@ -89,13 +164,16 @@ func New(config ClientConfig, details ...ClientDetail) *Client {
 		UserAgent: config.UserAgent,
 		Host:      config.Host,
 		APISecret: config.APISecret,
+		Logger:    config.Logger,
 		HttpClient: &http.Client{
 			Transport: http.DefaultTransport,
 		},
 	}
-	if config.ConnectConfig != nil && len(config.ConnectConfig.ConnectSecret) > 0 && len(config.ConnectConfig.ConnectUsername) > 0 {
-		client.ConnectSecret = config.ConnectConfig.ConnectSecret
-		client.ConnectUsername = config.ConnectConfig.ConnectUsername
+	if connect := config.ConnectConfig; connect != nil && len(connect.ConnectUsername) > 0 && (len(connect.ConnectSecret) > 0 || len(connect.ConnectToken) > 0) {
+		client.ConnectSecret = connect.ConnectSecret
+		client.ConnectUsername = connect.ConnectUsername
+		client.session.username = connect.ConnectUsername
+		client.session.token = connect.ConnectToken
 	}
 	for _, detail := range details {
 		detail.detail(client)
@@ -103,40 +181,199 @@ func New(config ClientConfig, details ...ClientDetail) *Client {
 	return client
 }
 
-func (c *Client) do(details ...raHttp.RequestDetail) (*raHttp.Response, error) {
+// do sends the request described by details, the request is bound to ctx so
+// cancellation and deadlines stop the call as soon as they happen
+func (c *Client) do(ctx context.Context, details ...raHttp.RequestDetail) (*raHttp.Response, error) {
 	r := raHttp.NewRequest(c.Host, details...)
+	handler := Handler(c.roundTrip)
+	if c.coalescer != nil {
+		handler = c.coalesced(handler)
+	}
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		handler = c.Middlewares[i](handler)
+	}
+	if c.Metrics != nil {
+		handler = c.measured(handler)
+	}
+	if c.Logger != nil {
+		handler = c.logged(handler)
+	}
+	resp, err := recordMetadata(handler)(ctx, r)
+	if err != nil {
+		return nil, redactError(err, r)
+	}
+	return resp, nil
+}
 
+// roundTrip turns the request into an http call, serving it from the cache and retrying it when configured
+func (c *Client) roundTrip(ctx context.Context, r *raHttp.Request) (*raHttp.Response, error) {
 	url := r.Host
 	if r.Path != "" {
 		url = fmt.Sprintf("%s%s", r.Host, r.Path)
 	}
 
-	req, err := http.NewRequest(r.Method, url, nil)
+	body, contentType, err := r.Body()
+	if err != nil {
+		return nil, fmt.Errorf("encoding request body: %w", err)
+	}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, url, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("creating new http request: %w", err)
 	}
 	q := req.URL.Query()
-	for k, v := range r.Params {
-		q.Add(k, v)
+	for k, vs := range r.Values() {
+		for _, v := range vs {
+			q.Add(k, v)
+		}
 	}
 	req.URL.RawQuery = q.Encode()
 	for k, v := range r.Headers {
 		req.Header.Add(k, v)
 	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	key, ttl, mode := "", time.Duration(0), cacheMode(ctx)
+	if c.Cache != nil && r.Method == http.MethodGet && mode != CacheBypass {
+		key, ttl = cacheKey(r), c.cacheTTL(r.Path)
+	}
+	if ttl > 0 && mode == CacheDefault {
+		if data, ok := c.Cache.Get(key); ok {
+			recordAttempts(ctx, 0)
+			return &raHttp.Response{
+				StatusCode:     http.StatusOK,
+				Data:           data,
+				StrictNotFound: c.StrictNotFound,
+				Cached:         true,
+			}, nil
+		}
+	}
+	stream := ttl == 0 && c.streams(ctx)
+	maxAttempts := c.RetryPolicy.maxAttempts(r.Method)
+	attempt := 1
+	for ; ; attempt++ {
+		resp, header, err := c.send(ctx, req, stream)
+		recordAttempts(ctx, attempt)
+		retry := attempt < maxAttempts && c.shouldRetry(ctx, resp, err)
+		wait := time.Duration(0)
+		if retry {
+			wait, retry = c.RetryPolicy.backoff(attempt, header)
+		}
+		if !retry {
+			if err != nil && attempt > 1 {
+				return nil, fmt.Errorf("after %d attempts: %w", attempt, err)
+			}
+			if err == nil && ttl > 0 && resp.StatusCode == http.StatusOK {
+				c.Cache.Set(key, resp.Data, ttl)
+			}
+			return resp, err
+		}
+		err = sleep(ctx, wait)
+		if err != nil {
+			return nil, fmt.Errorf("waiting to retry after %d attempts: %w", attempt, err)
+		}
+	}
+}
+
+// streams reports whether successful responses can be decoded straight from the connection instead of being
+// buffered, which is when nothing else needs their raw bytes: no metadata, logs, metrics, middlewares or coalescing
+func (c *Client) streams(ctx context.Context) bool {
+	return metadataFrom(ctx) == nil && c.Logger == nil && c.Metrics == nil && len(c.Middlewares) == 0 && c.coalescer == nil
+}
+
+// send makes a single attempt at the request, waiting on the rate limiter first. With stream, the body of a
+// successful response is left unread for ResponseObject or ResponseList to decode.
+func (c *Client) send(ctx context.Context, req *http.Request, stream bool) (*raHttp.Response, http.Header, error) {
+	if c.RateLimiter != nil {
+		err := c.RateLimiter.Wait(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("waiting for rate limiter: %w", err)
+		}
+	}
+	req = req.Clone(ctx)
+	if req.GetBody != nil {
+		// every attempt reads the body again
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading request body: %w", err)
+		}
+		req.Body = body
+	}
+	creds, err := c.authenticate(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
 	resp, err := c.HttpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, redactSecrets(err, creds.APISecret)
 	}
-	data, err := io.ReadAll(resp.Body)
+	if reporter, ok := c.Credentials.(CredentialsReporter); ok {
+		reporter.ReportCredentials(creds, resp.StatusCode)
+	}
+	maxSize := c.MaxResponseSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxResponseSize
+	}
+	finalURL := req.URL
+	if resp.Request != nil {
+		// the request of the response is the last one of any redirects
+		finalURL = resp.Request.URL
+	}
+	response := &raHttp.Response{
+		StatusCode:     resp.StatusCode,
+		Header:         resp.Header,
+		URL:            raHttp.RedactURL(finalURL.String()),
+		StrictNotFound: c.StrictNotFound,
+	}
+	if stream && resp.StatusCode == http.StatusOK {
+		response.Body, err = raHttp.LimitBody(resp.Body, resp.ContentLength, maxSize)
+		if err != nil {
+			_ = resp.Body.Close()
+			return nil, nil, err
+		}
+		return response, resp.Header, nil
+	}
+	response.Data, err = raHttp.ReadBody(resp.Body, resp.ContentLength, maxSize)
 	if err != nil {
-		return nil, err
+		_ = resp.Body.Close()
+		return nil, nil, err
 	}
 	err = resp.Body.Close()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	return response, resp.Header, nil
+}
+
+// authenticate sets the API key given by the credentials provider on requests sending one
+func (c *Client) authenticate(ctx context.Context, req *http.Request) (Credentials, error) {
+	q := req.URL.Query()
+	if c.Credentials == nil || !q.Has("y") {
+		return Credentials{}, nil
+	}
+	creds, err := c.Credentials.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("getting credentials: %w", err)
+	}
+	if creds.APISecret == "" {
+		return Credentials{}, ErrNoCredentials
+	}
+	q.Set("y", creds.APISecret)
+	req.URL.RawQuery = q.Encode()
+	return creds, nil
+}
+
+// shouldRetry reports whether a failed attempt is worth sending again
+func (c *Client) shouldRetry(ctx context.Context, resp *raHttp.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, ErrResponseTooLarge) && !errors.Is(err, ErrNoCredentials)
 	}
-	return &raHttp.Response{
-		StatusCode: resp.StatusCode,
-		Data:       data,
-	}, nil
+	return c.RetryPolicy.retryStatus(resp.StatusCode)
 }
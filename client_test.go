@@ -1,11 +1,19 @@
 package retroachievements_test
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,3 +57,324 @@ func TestNewClient(t *testing.T) {
 
 	require.Equal(t, expected, actual)
 }
+
+type ctxKey struct{}
+
+type roundTripperFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := client.GetGameContext(ctx, models.GetGameParameters{
+		GameID: 2991,
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp, err := client.GetUserSummaryContext(ctx, models.GetUserSummaryParameters{
+		Username: "jamiras",
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestContextValues(t *testing.T) {
+	var seen any
+	client := retroachievements.New(
+		retroachievements.ClientConfig{
+			Host:      "http://localhost",
+			UserAgent: "go-retroachievements/v0.0.0",
+			APISecret: "some_secret",
+		},
+		retroachievements.HttpClient(&http.Client{
+			Transport: roundTripperFn(func(req *http.Request) (*http.Response, error) {
+				seen = req.Context().Value(ctxKey{})
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("[]")),
+				}, nil
+			}),
+		}),
+	)
+	ctx := context.WithValue(context.Background(), ctxKey{}, "trace-id")
+	_, err := client.GetTopTenUsersContext(ctx, models.GetTopTenUsersParameters{})
+	require.NoError(t, err)
+	require.Equal(t, "trace-id", seen)
+}
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, err := w.Write([]byte(`{"message":"Too Many Attempts."}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	resp, err := client.GetGameExtended(models.GetGameExtentedParameters{
+		GameID: 2991,
+	})
+	require.Nil(t, resp)
+	apiErr := &retroachievements.APIError{}
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	require.Equal(t, "Too Many Attempts.", apiErr.Message)
+	require.ErrorIs(t, err, retroachievements.ErrRateLimited)
+	require.NotErrorIs(t, err, retroachievements.ErrServer)
+}
+
+func TestNotFoundErrors(tt *testing.T) {
+	endpoints := []struct {
+		name string
+		call func(client *retroachievements.Client) (any, error)
+	}{
+		{
+			name: "GetGame",
+			call: func(client *retroachievements.Client) (any, error) {
+				return client.GetGame(models.GetGameParameters{GameID: 1})
+			},
+		},
+		{
+			name: "GetTicketByID",
+			call: func(client *retroachievements.Client) (any, error) {
+				return client.GetTicketByID(models.GetTicketByIDParameters{TicketID: 1})
+			},
+		},
+		{
+			name: "GetUserProfile",
+			call: func(client *retroachievements.Client) (any, error) {
+				return client.GetUserProfile(models.GetUserProfileParameters{Username: "jamiras"})
+			},
+		},
+	}
+	responses := []struct {
+		name   string
+		code   int
+		body   string
+		strict bool
+		assert func(t *testing.T, resp any, err error)
+	}{
+		{
+			name:   "empty list",
+			code:   http.StatusOK,
+			body:   "[]",
+			strict: true,
+			assert: func(t *testing.T, resp any, err error) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrNotFound)
+			},
+		},
+		{
+			name:   "not found status",
+			code:   http.StatusNotFound,
+			body:   "{}",
+			strict: true,
+			assert: func(t *testing.T, resp any, err error) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrNotFound)
+			},
+		},
+		{
+			name:   "object",
+			code:   http.StatusOK,
+			body:   `{"ID":1}`,
+			strict: true,
+			assert: func(t *testing.T, resp any, err error) {
+				require.NotNil(t, resp)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "empty list without strict mode",
+			code:   http.StatusOK,
+			body:   "[]",
+			strict: false,
+			assert: func(t *testing.T, resp any, err error) {
+				require.Nil(t, resp)
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, endpoint := range endpoints {
+		for _, response := range responses {
+			tt.Run(endpoint.name+" "+response.name, func(t *testing.T) {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(response.code)
+					_, err := w.Write([]byte(response.body))
+					require.NoError(t, err)
+				}))
+				defer server.Close()
+				details := []retroachievements.ClientDetail{}
+				if response.strict {
+					details = append(details, retroachievements.NotFoundErrors())
+				}
+				client := retroachievements.New(retroachievements.ClientConfig{
+					Host:      server.URL,
+					UserAgent: "go-retroachievements/v0.0.0",
+					APISecret: "some_secret",
+				}, details...)
+				resp, err := endpoint.call(client)
+				response.assert(t, resp, err)
+			})
+		}
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	config := retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}
+	client := retroachievements.New(config, retroachievements.MaxResponseSize(10))
+	resp, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, retroachievements.ErrResponseTooLarge)
+
+	client = retroachievements.New(config, retroachievements.MaxResponseSize(25))
+	resp, err = client.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Twisted Metal", resp.Title)
+}
+
+func TestStreamedResponse(tt *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      func() context.Context
+		expected string
+	}{
+		{
+			name: "decoded from the connection",
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			// the body is only read once the endpoint parses it
+			expected: "parsing response object: response too large: body exceeds 10 bytes",
+		},
+		{
+			name: "buffered for metadata",
+			ctx: func() context.Context {
+				return retroachievements.WithMetadata(context.Background(), &retroachievements.ResponseMetadata{})
+			},
+			expected: "calling endpoint: response too large: body exceeds 10 bytes",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// flushing first sends the body without a content length
+				w.(http.Flusher).Flush()
+				_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      server.URL,
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			}, retroachievements.MaxResponseSize(10))
+			resp, err := client.GetGameContext(test.ctx(), models.GetGameParameters{GameID: 1})
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, retroachievements.ErrResponseTooLarge)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestRequestBody(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, []string{"1", "2"}, r.URL.Query()["f"])
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		require.Equal(t, "uploadbadgeimage", r.FormValue("r"))
+		f, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "badge.png", header.Filename)
+		require.Equal(t, "png data", string(data))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+	},
+		retroachievements.CoalesceRequests(),
+		retroachievements.Retry(retroachievements.RetryPolicy{
+			MaxAttempts: 2,
+			StatusCodes: []int{http.StatusServiceUnavailable},
+		}),
+	)
+	type uploadResponse struct {
+		Success bool
+	}
+	resp, err := retroachievements.Send[uploadResponse](context.Background(), client,
+		raHttp.Method(http.MethodPost),
+		raHttp.Path("/dorequest.php"),
+		raHttp.QueryValues(url.Values{"f": {"1", "2"}}),
+		raHttp.FormValue("r", "uploadbadgeimage"),
+		raHttp.FilePart(raHttp.Part{Field: "file", FileName: "badge.png", ContentType: "image/png", Data: []byte("png data")}),
+	)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, retroachievements.ErrServer)
+	// a request sending a body is never retried, it could have taken effect before failing
+	require.Equal(t, 1, attempts)
+}
+
+// capturedQuery returns the query string call sent to the server, leaving out the API key
+func capturedQuery(t *testing.T, call func(client *retroachievements.Client) error) string {
+	query := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Del("y")
+		query = q.Encode()
+		_, err := w.Write([]byte(`{}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	require.NoError(t, call(client))
+	return query
+}
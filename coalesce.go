@@ -0,0 +1,96 @@
+package retroachievements
+
+import (
+	"context"
+	"sync"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// CoalesceRequests makes concurrent calls with the same endpoint and parameters share a single in-flight
+// request and its decoded result, which callers must then treat as read only
+func CoalesceRequests() ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.coalescer = &coalescer{
+			flights: map[string]*flight{},
+		}
+	})
+}
+
+// coalescer tracks the requests currently in flight
+type coalescer struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a request shared by every caller waiting on it, it is canceled once all of them gave up.
+// attempts counts how many times the request was sent, for every waiter to report.
+type flight struct {
+	done     chan struct{}
+	cancel   context.CancelFunc
+	waiters  int
+	attempts int
+	resp     *raHttp.Response
+	err      error
+}
+
+// coalesced wraps a handler so identical concurrent requests are only sent once, a caller canceling
+// its context stops waiting without canceling the request for the others. Only GET and HEAD requests are
+// shared, others could change state on every call.
+func (c *Client) coalesced(next Handler) Handler {
+	return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+		if !idempotent(req.Method) || req.HasBody() {
+			return next(ctx, req)
+		}
+		g := c.coalescer
+		key := req.Method + " " + cacheKey(req)
+		g.mu.Lock()
+		f, ok := g.flights[key]
+		if !ok {
+			f = &flight{
+				done: make(chan struct{}),
+			}
+			// the flight outlives the caller that started it, so it records its attempts for every waiter to copy
+			flightCtx, cancel := context.WithCancel(WithAttempts(context.WithoutCancel(ctx), &f.attempts))
+			f.cancel = cancel
+			g.flights[key] = f
+			go func() {
+				defer cancel()
+				resp, err := next(flightCtx, req)
+				if resp != nil {
+					resp = resp.Share()
+				}
+				f.resp, f.err = resp, err
+				g.mu.Lock()
+				if g.flights[key] == f {
+					delete(g.flights, key)
+				}
+				g.mu.Unlock()
+				close(f.done)
+			}()
+		}
+		f.waiters++
+		g.mu.Unlock()
+
+		select {
+		case <-f.done:
+			recordAttempts(ctx, f.attempts)
+			if f.err != nil {
+				return nil, f.err
+			}
+			return f.resp.Share(), nil
+		case <-ctx.Done():
+			g.mu.Lock()
+			f.waiters--
+			if f.waiters == 0 {
+				// later callers must start a new flight instead of joining the canceled one
+				f.cancel()
+				if g.flights[key] == f {
+					delete(g.flights, key)
+				}
+			}
+			g.mu.Unlock()
+			return nil, ctx.Err()
+		}
+	}
+}
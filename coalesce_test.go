@@ -0,0 +1,248 @@
+package retroachievements_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func newCoalesceServer(t *testing.T, release chan struct{}, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, err := w.Write([]byte(`{"ID":1,"Title":"Twisted Metal"}`))
+		require.NoError(t, err)
+	}))
+}
+
+func TestCoalesceRequests(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := newCoalesceServer(t, release, &calls)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.CoalesceRequests())
+
+	results := make([]*models.GetGameExtented, 5)
+	errs := make([]error, 5)
+	wg := sync.WaitGroup{}
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = client.GetGameExtended(models.GetGameExtentedParameters{GameID: 1})
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), calls.Load())
+	for i, resp := range results {
+		require.NoError(t, errs[i])
+		require.Same(t, results[0], resp)
+		require.Equal(t, "Twisted Metal", resp.Title)
+	}
+
+	_, err := client.GetGameExtended(models.GetGameExtentedParameters{GameID: 2})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestCoalesceRequestsCallerCanceled(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := newCoalesceServer(t, release, &calls)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.CoalesceRequests())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := client.GetUserSummaryContext(ctx, models.GetUserSummaryParameters{Username: "jamiras"})
+		canceled <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	done := make(chan error)
+	go func() {
+		_, err := client.GetUserSummary(models.GetUserSummaryParameters{Username: "jamiras"})
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-canceled, context.Canceled)
+	close(release)
+	require.NoError(t, <-done)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestCoalesceRequestsAllCanceled(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	defer close(release)
+	server := newCoalesceServer(t, release, &calls)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.CoalesceRequests())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the abandoned flight is canceled, so a new call starts a fresh request
+	require.Eventually(t, func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		_, _ = client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+		return calls.Load() >= 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+// blockingTransport holds every request until released, then fails the ones whose context was canceled meanwhile
+type blockingTransport struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (bt *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bt.calls.Add(1)
+	<-bt.release
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"ID":1,"Title":"Twisted Metal"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestCoalesceRequestsAfterAllCanceled(t *testing.T) {
+	transport := &blockingTransport{release: make(chan struct{})}
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "http://localhost",
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.HttpClient(&http.Client{Transport: transport}), retroachievements.CoalesceRequests())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+		canceled <- err
+	}()
+	require.Eventually(t, func() bool {
+		return transport.calls.Load() == 1
+	}, time.Second, time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-canceled, context.Canceled)
+
+	// the canceled flight is still waiting on the transport, a new caller must not join it
+	done := make(chan error)
+	go func() {
+		_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		return transport.calls.Load() == 2
+	}, time.Second, time.Millisecond)
+	close(transport.release)
+	require.NoError(t, <-done)
+}
+
+func TestCoalesceRequestsSkipsPosts(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := newCoalesceServer(t, release, &calls)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.CoalesceRequests())
+
+	errs := make([]error, 3)
+	wg := sync.WaitGroup{}
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = retroachievements.Send[models.GetGame](context.Background(), client,
+				raHttp.Method(http.MethodPost),
+				raHttp.Path("/dorequest.php"),
+				raHttp.R("submitlbentry"),
+				raHttp.Param("i", "1"),
+			)
+		}()
+	}
+	// every post reaches the server, a shared flight would only send one
+	require.Eventually(t, func() bool {
+		return calls.Load() == 3
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestCoalesceRequestsAttempts(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := newCoalesceServer(t, release, &calls)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.CoalesceRequests())
+
+	attempts := make([]int, 3)
+	metadata := make([]retroachievements.ResponseMetadata, 3)
+	errs := make([]error, 3)
+	wg := sync.WaitGroup{}
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := retroachievements.WithMetadata(retroachievements.WithAttempts(context.Background(), &attempts[i]), &metadata[i])
+			_, errs[i] = client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), calls.Load())
+	for i := range errs {
+		require.NoError(t, errs[i])
+		// every caller waited on a request that reached the network
+		require.Equal(t, 1, attempts[i])
+		require.Equal(t, 1, metadata[i].Attempts)
+		require.False(t, metadata[i].Cached)
+	}
+}
@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,7 +11,17 @@ import (
 )
 
 // GetComments gets comments of a specified kind: game, achievement, or user.
+//
+// GetComments uses context.Background internally; to specify the context, use GetCommentsContext.
 func (c *Client) GetComments(params models.GetCommentsParameters) (*models.GetComments, error) {
+	return c.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext gets comments of a specified kind: game, achievement, or user.
+func (c *Client) GetCommentsContext(ctx context.Context, params models.GetCommentsParameters) (*models.GetComments, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -35,7 +46,14 @@ func (c *Client) GetComments(params models.GetCommentsParameters) (*models.GetCo
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	if params.NewestFirst != nil {
+		sort := "submitted"
+		if *params.NewestFirst {
+			sort = "-submitted"
+		}
+		details = append(details, raHttp.Sort(sort))
+	}
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -45,7 +45,7 @@ func TestGetComments(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetComments, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetComments.php?c=10&i=jamiras&o=12&t=3&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetComments.php?c=10&i=jamiras&o=12&t=3&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -222,3 +222,36 @@ func TestGetComments(tt *testing.T) {
 		})
 	}
 }
+
+func TestGetCommentsQuery(tt *testing.T) {
+	newestFirst, oldestFirst := true, false
+	tests := []struct {
+		name     string
+		params   models.GetCommentsParameters
+		expected string
+	}{
+		{
+			name:     "default sort",
+			params:   models.GetCommentsParameters{Type: models.GetCommentsGame{GameID: 1}},
+			expected: "i=1&t=1",
+		},
+		{
+			name:     "newest first",
+			params:   models.GetCommentsParameters{Type: models.GetCommentsGame{GameID: 1}, NewestFirst: &newestFirst},
+			expected: "i=1&sort=-submitted&t=1",
+		},
+		{
+			name:     "oldest first",
+			params:   models.GetCommentsParameters{Type: models.GetCommentsAchievement{AchievementID: 2}, NewestFirst: &oldestFirst},
+			expected: "i=2&sort=submitted&t=2",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, capturedQuery(t, func(client *retroachievements.Client) error {
+				_, err := client.GetComments(test.params)
+				return err
+			}))
+		})
+	}
+}
@@ -1,16 +1,32 @@
 package retroachievements
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"sync"
 
 	raHttp "github.com/joshraphael/go-retroachievements/http"
 	"github.com/joshraphael/go-retroachievements/models"
 )
 
 // GetCodeNotes gets the list of code notes for a given game.
+//
+// GetCodeNotes uses context.Background internally; to specify the context, use GetCodeNotesContext.
 func (c *Client) GetCodeNotes(params models.GetCodeNotesParameters) (*models.GetCodeNotes, error) {
+	return c.GetCodeNotesContext(context.Background(), params)
+}
+
+// GetCodeNotesContext gets the list of code notes for a given game.
+func (c *Client) GetCodeNotesContext(ctx context.Context, params models.GetCodeNotesParameters) (*models.GetCodeNotes, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/dorequest.php"),
@@ -26,3 +42,182 @@ func (c *Client) GetCodeNotes(params models.GetCodeNotesParameters) (*models.Get
 	}
 	return resp, nil
 }
+
+// connectAnonymous lists the Connect request kinds answered without a session token
+var connectAnonymous = map[string]bool{
+	"login":             true,
+	"login2":            true,
+	"allprogress":       true,
+	"codenotes2":        true,
+	"gameid":            true,
+	"gameslist":         true,
+	"hashlibrary":       true,
+	"latestclient":      true,
+	"latestintegration": true,
+	"officialgameslist": true,
+}
+
+var errNoSessionToken = errors.New("no session token returned")
+
+// connectSession holds the user and token authenticating the Connect requests of a client
+type connectSession struct {
+	mu       sync.Mutex
+	username string
+	token    string
+}
+
+// Login logs into the Connect API with a password or a token from an earlier login. The client keeps the returned
+// session token and sends it with every Connect request needing authentication.
+//
+// Login uses context.Background internally; to specify the context, use LoginContext.
+func (c *Client) Login(params models.LoginParameters) (*models.Login, error) {
+	return c.LoginContext(context.Background(), params)
+}
+
+// LoginContext logs into the Connect API with a password or a token from an earlier login.
+func (c *Client) LoginContext(ctx context.Context, params models.LoginParameters) (*models.Login, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
+	resp, err := c.login(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	c.session.username, c.session.token = cmp.Or(resp.User, params.Username), resp.Token
+	return resp, nil
+}
+
+// ConnectToken returns the current Connect session token, empty before the first login. Store it to log in
+// again later without the password.
+func (c *Client) ConnectToken() string {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	return c.session.token
+}
+
+// login calls login2, sending the password or token in the request body so it stays out of the URL
+func (c *Client) login(ctx context.Context, params models.LoginParameters) (*models.Login, error) {
+	details := []raHttp.RequestDetail{
+		raHttp.Method(http.MethodPost),
+		raHttp.UserAgent(c.UserAgent),
+		raHttp.Path("/dorequest.php"),
+		raHttp.R("login2"),
+		raHttp.U(params.Username),
+	}
+	if params.Password != "" {
+		details = append(details, raHttp.FormValue("p", params.Password))
+	} else {
+		details = append(details, raHttp.FormValue("t", params.Token))
+	}
+	r, err := c.do(ctx, details...)
+	if err != nil {
+		return nil, fmt.Errorf("calling endpoint: %w", err)
+	}
+	resp, err := raHttp.ResponseObject[models.Login](r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response object: %w", err)
+	}
+	if resp == nil || resp.Token == "" {
+		return nil, fmt.Errorf("parsing response object: %w", errNoSessionToken)
+	}
+	return resp, nil
+}
+
+// hasConnectSession reports whether the client has a user to authenticate Connect requests as, a failing
+// credentials provider counts as one so its error is reported
+func (c *Client) hasConnectSession(ctx context.Context) bool {
+	c.session.mu.Lock()
+	username := c.session.username
+	c.session.mu.Unlock()
+	if username != "" {
+		return true
+	}
+	username, _, err := c.connectCredentials(ctx)
+	return err != nil || username != ""
+}
+
+// connectCredentials returns the username and password to log into the Connect API with. The credentials provider
+// is asked first so a rotated password is picked up, the ConnectConfig ones are used when it has none.
+func (c *Client) connectCredentials(ctx context.Context) (string, string, error) {
+	username, password := c.ConnectUsername, c.ConnectSecret
+	if c.Credentials != nil {
+		creds, err := c.Credentials.Credentials(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("getting credentials: %w", err)
+		}
+		if creds.ConnectUsername != "" && creds.ConnectSecret != "" {
+			username, password = creds.ConnectUsername, creds.ConnectSecret
+		}
+	}
+	return username, password, nil
+}
+
+// connectSession returns the user and token to authenticate Connect requests with, logging in with the
+// password when there is no token yet
+func (c *Client) connectSession(ctx context.Context) (string, string, error) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	if c.session.token != "" {
+		return c.session.username, c.session.token, nil
+	}
+	return c.relogin(ctx)
+}
+
+// renewConnectSession replaces a token rejected by the API by logging in with the password again, unless
+// another request already did while this one was waiting
+func (c *Client) renewConnectSession(ctx context.Context, rejected string) (string, string, error) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	if c.session.token != "" && c.session.token != rejected {
+		return c.session.username, c.session.token, nil
+	}
+	return c.relogin(ctx)
+}
+
+// relogin logs in with the password and stores the new token, the caller holds the session lock
+func (c *Client) relogin(ctx context.Context) (string, string, error) {
+	username, password, err := c.connectCredentials(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("logging in: %w", err)
+	}
+	username = cmp.Or(username, c.session.username)
+	if username == "" || password == "" {
+		return "", "", fmt.Errorf("logging in: %w", ErrNoCredentials)
+	}
+	resp, err := c.login(ctx, models.LoginParameters{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("logging in: %w", err)
+	}
+	c.session.username, c.session.token = cmp.Or(resp.User, username), resp.Token
+	return c.session.username, c.session.token, nil
+}
+
+// doConnect sends a Connect request as the logged in user. When the API rejects the session token and the client
+// or its credentials provider has the password, it logs in again and sends the request once more.
+func (c *Client) doConnect(ctx context.Context, details ...raHttp.RequestDetail) (*raHttp.Response, error) {
+	authenticated := func(username string, token string) []raHttp.RequestDetail {
+		return append(slices.Clip(details), raHttp.U(username), raHttp.T(token))
+	}
+	username, token, err := c.connectSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.do(ctx, authenticated(username, token)...)
+	if err != nil || r.StatusCode != http.StatusUnauthorized {
+		return r, err
+	}
+	username, token, err = c.renewConnectSession(ctx, token)
+	if errors.Is(err, ErrNoCredentials) {
+		// without a password the rejected token can not be replaced
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, authenticated(username, token)...)
+}
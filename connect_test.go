@@ -1,9 +1,15 @@
 package retroachievements_test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/joshraphael/go-retroachievements"
@@ -139,3 +145,330 @@ func TestGetCodeNotes(tt *testing.T) {
 		})
 	}
 }
+
+func TestLogin(tt *testing.T) {
+	tests := []struct {
+		name            string
+		params          models.LoginParameters
+		modifyURL       func(url string) string
+		responseCode    int
+		responseMessage models.Login
+		response        func(messageBytes []byte) []byte
+		assert          func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error)
+	}{
+		{
+			name: "validation error",
+			params: models.LoginParameters{
+				Username: "jamiras",
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrValidation)
+				require.EqualError(t, err, "validating parameters: invalid parameters: Password must be set when Token is empty")
+			},
+		},
+		{
+			name: "fail to call endpoint",
+			params: models.LoginParameters{
+				Username: "jamiras",
+				Password: "hunter2",
+			},
+			modifyURL: func(url string) string {
+				return ""
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error) {
+				require.Nil(t, resp)
+				require.EqualError(t, err, "calling endpoint: Post \"/dorequest.php?r=login2&u=jamiras\": unsupported protocol scheme \"\"")
+			},
+		},
+		{
+			name: "invalid credentials",
+			params: models.LoginParameters{
+				Username: "jamiras",
+				Password: "hunter2",
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusUnauthorized,
+			response: func(messageBytes []byte) []byte {
+				return []byte(`{"Success":false,"Status":401,"Code":"invalid_credentials","Error":"Invalid username/password."}`)
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+				require.Empty(t, client.ConnectToken())
+			},
+		},
+		{
+			name: "no token returned",
+			params: models.LoginParameters{
+				Username: "jamiras",
+				Token:    "old_token",
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusOK,
+			responseMessage: models.Login{
+				Success: true,
+				User:    "jamiras",
+			},
+			response: func(messageBytes []byte) []byte {
+				return messageBytes
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error) {
+				require.Nil(t, resp)
+				require.EqualError(t, err, "parsing response object: no session token returned")
+			},
+		},
+		{
+			name: "success",
+			params: models.LoginParameters{
+				Username: "jamiras",
+				Password: "hunter2",
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusOK,
+			responseMessage: models.Login{
+				Success:       true,
+				User:          "Jamiras",
+				Token:         "session_token",
+				Score:         100,
+				SoftcoreScore: 5,
+				Permissions:   4,
+				AccountType:   "Administrator",
+			},
+			response: func(messageBytes []byte) []byte {
+				return messageBytes
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, resp *models.Login, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.True(t, resp.Success)
+				require.Equal(t, "Jamiras", resp.User)
+				require.Equal(t, "session_token", resp.Token)
+				require.Equal(t, 100, resp.Score)
+				require.Equal(t, "Administrator", resp.AccountType)
+				require.Equal(t, "session_token", client.ConnectToken())
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "/dorequest.php", r.URL.Path)
+				require.Equal(t, "login2", r.URL.Query().Get("r"))
+				require.False(t, r.URL.Query().Has("p"))
+				require.False(t, r.URL.Query().Has("t"))
+				require.NoError(t, r.ParseForm())
+				require.Equal(t, test.params.Password, r.PostForm.Get("p"))
+				if test.params.Password == "" {
+					require.Equal(t, test.params.Token, r.PostForm.Get("t"))
+				}
+				w.WriteHeader(test.responseCode)
+				messageBytes, err := json.Marshal(test.responseMessage)
+				require.NoError(t, err)
+				resp := test.response(messageBytes)
+				num, err := w.Write(resp)
+				require.NoError(t, err)
+				require.Equal(t, num, len(resp))
+			}))
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      test.modifyURL(server.URL),
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			})
+			resp, err := client.Login(test.params)
+			test.assert(t, client, resp, err)
+		})
+	}
+}
+
+// connectServer answers login2 with a new token for the right password and accepts other requests
+// only with the latest token
+func connectServer(t *testing.T, logins *atomic.Int32) *httptest.Server {
+	var token atomic.Value
+	token.Store("")
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch r.Form.Get("r") {
+		case "login2":
+			if r.Form.Get("p") != "hunter2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"Success":false,"Status":401,"Code":"invalid_credentials","Error":"Invalid username/password."}`))
+				return
+			}
+			n := logins.Add(1)
+			token.Store(fmt.Sprintf("token_%d", n))
+			_, _ = fmt.Fprintf(w, `{"Success":true,"User":"jamiras","Token":"token_%d"}`, n)
+		case "codenotes2":
+			require.False(t, r.Form.Has("t"))
+			_, _ = w.Write([]byte(`{"Success":true,"CodeNotes":[]}`))
+		default:
+			if r.Form.Get("u") != "jamiras" || r.Form.Get("t") == "" || r.Form.Get("t") != token.Load() {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"Success":false,"Status":401,"Code":"invalid_token","Error":"Invalid token"}`))
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"Success":true,"Token":%q}`, r.Form.Get("t"))
+		}
+	}))
+}
+
+func TestConnectSession(tt *testing.T) {
+	type response struct {
+		Success bool
+		Token   string
+	}
+	tests := []struct {
+		name   string
+		config retroachievements.ClientConnectConfig
+		assert func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32)
+	}{
+		{
+			name: "logs in with the password before the first request",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectSecret:   "hunter2",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				for range 2 {
+					resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+					require.NoError(t, err)
+					require.Equal(t, "token_1", resp.Token)
+				}
+				require.Equal(t, int32(1), logins.Load())
+				require.Equal(t, "token_1", client.ConnectToken())
+			},
+		},
+		{
+			name: "logs in again when the token expired",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectSecret:   "hunter2",
+				ConnectToken:    "expired_token",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+				require.NoError(t, err)
+				require.Equal(t, "token_1", resp.Token)
+				require.Equal(t, int32(1), logins.Load())
+			},
+		},
+		{
+			name: "concurrent requests log in once",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectSecret:   "hunter2",
+				ConnectToken:    "expired_token",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				var wg sync.WaitGroup
+				errs := make([]error, 8)
+				for i := range errs {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						_, errs[i] = retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+					}()
+				}
+				wg.Wait()
+				for _, err := range errs {
+					require.NoError(t, err)
+				}
+				require.Equal(t, int32(1), logins.Load())
+			},
+		},
+		{
+			name: "expired token without a password",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectToken:    "expired_token",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+				require.NotContains(t, err.Error(), "expired_token")
+				require.Zero(t, logins.Load())
+			},
+		},
+		{
+			name: "wrong password",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectSecret:   "wrong_password",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+				require.NotContains(t, err.Error(), "wrong_password")
+			},
+		},
+		{
+			name: "anonymous requests skip the session",
+			config: retroachievements.ClientConnectConfig{
+				ConnectUsername: "jamiras",
+				ConnectSecret:   "hunter2",
+			},
+			assert: func(t *testing.T, client *retroachievements.Client, logins *atomic.Int32) {
+				resp, err := client.GetCodeNotes(models.GetCodeNotesParameters{GameID: 1})
+				require.NoError(t, err)
+				require.True(t, resp.Success)
+				require.Zero(t, logins.Load())
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			logins := &atomic.Int32{}
+			server := connectServer(t, logins)
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:          server.URL,
+				UserAgent:     "go-retroachievements/v0.0.0",
+				APISecret:     "some_secret",
+				ConnectConfig: &test.config,
+			})
+			test.assert(t, client, logins)
+		})
+	}
+}
+
+func TestConnectSessionRotatedPassword(t *testing.T) {
+	logins := &atomic.Int32{}
+	server := connectServer(t, logins)
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"connect_username":"jamiras","connect_secret":"old_password"}`), 0o600))
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		ConnectConfig: &retroachievements.ClientConnectConfig{
+			ConnectUsername: "jamiras",
+			ConnectToken:    "expired_token",
+		},
+	}, retroachievements.ProvideCredentials(retroachievements.NewFileCredentials(path)))
+	type response struct {
+		Token string
+	}
+
+	_, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+	require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+	require.NotContains(t, err.Error(), "old_password")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"connect_username":"jamiras","connect_secret":"hunter2"}`), 0o600))
+	resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+	require.NoError(t, err)
+	require.Equal(t, "token_1", resp.Token)
+	require.Equal(t, int32(1), logins.Load())
+}
@@ -0,0 +1,197 @@
+package retroachievements
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned when a credentials provider has no API key to give
+var ErrNoCredentials = errors.New("no credentials available")
+
+// Credentials holds the secrets used to authenticate with the API
+type Credentials struct {
+	// Web API key sent with every web API request
+	APISecret string `json:"api_secret"`
+
+	// Username used to log into the Connect API, in place of the ConnectConfig one when ConnectSecret is also set
+	ConnectUsername string `json:"connect_username"`
+
+	// Password used to log into the Connect API, asked for on every login so a rotated password is picked up
+	ConnectSecret string `json:"connect_secret"`
+}
+
+// CredentialsProvider gives the credentials to use for a request, implementations must be safe for concurrent use
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsReporter is implemented by providers that want to know how the API answered a request made with their credentials
+type CredentialsReporter interface {
+	ReportCredentials(creds Credentials, statusCode int)
+}
+
+// CredentialsProviderFunc turns a function into a credentials provider
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls the function
+func (fn CredentialsProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return fn(ctx)
+}
+
+// ProvideCredentials makes the client ask the provider for credentials on every request instead of using a fixed API key
+func ProvideCredentials(provider CredentialsProvider) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.Credentials = provider
+	})
+}
+
+// StaticCredentials always provides the same credentials
+func StaticCredentials(creds Credentials) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		return creds, nil
+	})
+}
+
+// EnvCredentials reads credentials from environment variables on every request, so they can be rotated
+// by changing the environment. Empty variable names fall back to RA_API_KEY, RA_CONNECT_USERNAME and RA_CONNECT_SECRET.
+type EnvCredentials struct {
+	APISecretVar       string
+	ConnectUsernameVar string
+	ConnectSecretVar   string
+}
+
+// Credentials reads the environment variables
+func (e EnvCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	lookup := func(name string, fallback string) string {
+		if name == "" {
+			name = fallback
+		}
+		return os.Getenv(name)
+	}
+	return Credentials{
+		APISecret:       lookup(e.APISecretVar, "RA_API_KEY"),
+		ConnectUsername: lookup(e.ConnectUsernameVar, "RA_CONNECT_USERNAME"),
+		ConnectSecret:   lookup(e.ConnectSecretVar, "RA_CONNECT_SECRET"),
+	}, nil
+}
+
+// FileCredentials reads credentials from a file, reloading it whenever it changes on disk. The file
+// holds either a JSON encoded Credentials object or only the web API key.
+type FileCredentials struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	creds   Credentials
+}
+
+// NewFileCredentials creates a provider reading credentials from the file at path
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{
+		path: path,
+	}
+}
+
+// Credentials returns the credentials held by the file, reading it again if it changed since the last call
+func (f *FileCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("reading credentials file: %w", err)
+	}
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.creds, nil
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("reading credentials file: %w", err)
+	}
+	data = bytes.TrimSpace(data)
+	creds := Credentials{}
+	if bytes.HasPrefix(data, []byte("{")) {
+		err = json.Unmarshal(data, &creds)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("parsing credentials file: %w", err)
+		}
+	} else {
+		creds.APISecret = string(data)
+	}
+	f.creds, f.modTime, f.size = creds, info.ModTime(), info.Size()
+	return creds, nil
+}
+
+// KeyPool spreads requests round-robin across several web API keys, a key answered with
+// a 401 or 429 status is left out of the rotation until its cooldown is over
+type KeyPool struct {
+	mu        sync.Mutex
+	keys      []string
+	next      int
+	cooldown  time.Duration
+	sidelined map[string]time.Time
+}
+
+// NewKeyPool creates a pool rotating through keys, sidelining failing keys for cooldown
+func NewKeyPool(cooldown time.Duration, keys ...string) *KeyPool {
+	return &KeyPool{
+		keys:      keys,
+		cooldown:  cooldown,
+		sidelined: map[string]time.Time{},
+	}
+}
+
+// Credentials returns the next available key, or the key coming back the soonest if all of them are sidelined
+func (p *KeyPool) Credentials(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) == 0 {
+		return Credentials{}, ErrNoCredentials
+	}
+	now := time.Now()
+	soonest := -1
+	for i := range p.keys {
+		idx := (p.next + i) % len(p.keys)
+		until, ok := p.sidelined[p.keys[idx]]
+		if !ok || !now.Before(until) {
+			delete(p.sidelined, p.keys[idx])
+			p.next = idx + 1
+			return Credentials{APISecret: p.keys[idx]}, nil
+		}
+		if soonest < 0 || until.Before(p.sidelined[p.keys[soonest]]) {
+			soonest = idx
+		}
+	}
+	p.next = soonest + 1
+	return Credentials{APISecret: p.keys[soonest]}, nil
+}
+
+// ReportCredentials sidelines a key when the API rejected it or rate limited it
+func (p *KeyPool) ReportCredentials(creds Credentials, statusCode int) {
+	if statusCode != http.StatusUnauthorized && statusCode != http.StatusTooManyRequests {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sidelined[creds.APISecret] = time.Now().Add(p.cooldown)
+}
+
+// Available returns the number of keys currently in the rotation
+func (p *KeyPool) Available() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	available := 0
+	for _, key := range p.keys {
+		if until, ok := p.sidelined[key]; !ok || !now.Before(until) {
+			available++
+		}
+	}
+	return available
+}
@@ -0,0 +1,142 @@
+package retroachievements_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticCredentials(t *testing.T) {
+	creds, err := retroachievements.StaticCredentials(retroachievements.Credentials{
+		APISecret: "some_secret",
+	}).Credentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "some_secret", creds.APISecret)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("RA_API_KEY", "some_secret")
+	t.Setenv("RA_CONNECT_USERNAME", "jamiras")
+	t.Setenv("MY_CONNECT_SECRET", "some_other_secret")
+	creds, err := retroachievements.EnvCredentials{
+		ConnectSecretVar: "MY_CONNECT_SECRET",
+	}.Credentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, retroachievements.Credentials{
+		APISecret:       "some_secret",
+		ConnectUsername: "jamiras",
+		ConnectSecret:   "some_other_secret",
+	}, creds)
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	provider := retroachievements.NewFileCredentials(path)
+	_, err := provider.Credentials(context.Background())
+	require.ErrorContains(t, err, "reading credentials file")
+
+	require.NoError(t, os.WriteFile(path, []byte("some_secret\n"), 0o600))
+	creds, err := provider.Credentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, retroachievements.Credentials{APISecret: "some_secret"}, creds)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":"new_secret","connect_username":"jamiras"}`), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	creds, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, retroachievements.Credentials{APISecret: "new_secret", ConnectUsername: "jamiras"}, creds)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"api_secret":`), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	_, err = provider.Credentials(context.Background())
+	require.ErrorContains(t, err, "parsing credentials file")
+}
+
+func TestKeyPool(t *testing.T) {
+	pool := retroachievements.NewKeyPool(time.Minute, "key1", "key2", "key3")
+	next := func() string {
+		creds, err := pool.Credentials(context.Background())
+		require.NoError(t, err)
+		return creds.APISecret
+	}
+	require.Equal(t, []string{"key1", "key2", "key3", "key1"}, []string{next(), next(), next(), next()})
+	pool.ReportCredentials(retroachievements.Credentials{APISecret: "key2"}, http.StatusTooManyRequests)
+	pool.ReportCredentials(retroachievements.Credentials{APISecret: "key3"}, http.StatusOK)
+	require.Equal(t, 2, pool.Available())
+	require.Equal(t, []string{"key3", "key1", "key3"}, []string{next(), next(), next()})
+	pool.ReportCredentials(retroachievements.Credentials{APISecret: "key1"}, http.StatusUnauthorized)
+	pool.ReportCredentials(retroachievements.Credentials{APISecret: "key3"}, http.StatusUnauthorized)
+	require.Equal(t, 0, pool.Available())
+	require.Equal(t, "key2", next())
+
+	_, err := retroachievements.NewKeyPool(time.Minute).Credentials(context.Background())
+	require.ErrorIs(t, err, retroachievements.ErrNoCredentials)
+}
+
+func TestProvideCredentials(t *testing.T) {
+	keys := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("y")
+		keys = append(keys, key)
+		if key == "bad_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := fmt.Fprint(w, `{"Title":"Twisted Metal"}`)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	pool := retroachievements.NewKeyPool(time.Minute, "bad_key", "good_key")
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+	}, retroachievements.ProvideCredentials(pool), retroachievements.Retry(retroachievements.RetryPolicy{
+		MaxAttempts: 2,
+		StatusCodes: []int{http.StatusUnauthorized},
+	}))
+	game, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Twisted Metal", game.Title)
+	_, err = client.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bad_key", "good_key", "good_key"}, keys)
+	require.Equal(t, 1, pool.Available())
+}
+
+func TestProvideCredentialsError(t *testing.T) {
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "http://localhost",
+		UserAgent: "go-retroachievements/v0.0.0",
+	}, retroachievements.ProvideCredentials(retroachievements.StaticCredentials(retroachievements.Credentials{})))
+	_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.ErrorIs(t, err, retroachievements.ErrNoCredentials)
+}
+
+func TestProvideConnectCredentials(t *testing.T) {
+	t.Setenv("RA_CONNECT_USERNAME", "jamiras")
+	t.Setenv("RA_CONNECT_SECRET", "hunter2")
+	logins := &atomic.Int32{}
+	server := connectServer(t, logins)
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+	}, retroachievements.ProvideCredentials(retroachievements.EnvCredentials{}))
+	type response struct {
+		Token string
+	}
+	resp, err := retroachievements.Call[response](context.Background(), client, "/dorequest.php", map[string]string{"r": "unlocks"})
+	require.NoError(t, err)
+	require.Equal(t, "token_1", resp.Token)
+	require.Equal(t, int32(1), logins.Load())
+}
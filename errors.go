@@ -0,0 +1,54 @@
+package retroachievements
+
+import (
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// APIError is returned when the API responds with an unexpected status code, use errors.As to inspect it
+type APIError = raHttp.APIError
+
+// ValidationError is returned before any request is sent when parameters break their documented constraints,
+// use errors.As to list the offending fields. It also matches ErrValidation.
+type ValidationError = models.ValidationError
+
+// FieldError describes a single invalid parameter of a ValidationError
+type FieldError = models.FieldError
+
+var (
+	// ErrUnauthorized matches API errors caused by a missing or invalid API key
+	ErrUnauthorized = raHttp.ErrUnauthorized
+
+	// ErrRateLimited matches API errors caused by too many requests
+	ErrRateLimited = raHttp.ErrRateLimited
+
+	// ErrValidation matches API errors and client side ValidationErrors caused by invalid request parameters
+	ErrValidation = raHttp.ErrValidation
+
+	// ErrServer matches API errors caused by a problem on the server
+	ErrServer = raHttp.ErrServer
+
+	// ErrNotFound is returned by endpoints returning a single object when the resource does not exist, see NotFoundErrors
+	ErrNotFound = raHttp.ErrNotFound
+
+	// ErrResponseTooLarge is returned when a response body is bigger than the maximum size, see MaxResponseSize
+	ErrResponseTooLarge = raHttp.ErrResponseTooLarge
+)
+
+// validationError ties a client side ValidationError to ErrValidation
+type validationError struct {
+	error
+}
+
+func (e validationError) Unwrap() []error {
+	return []error{e.error, ErrValidation}
+}
+
+// validateParameters checks endpoint parameters so invalid ones fail without a network call
+func validateParameters(params interface{ Validate() error }) error {
+	err := params.Validate()
+	if err != nil {
+		return validationError{err}
+	}
+	return nil
+}
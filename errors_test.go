@@ -0,0 +1,38 @@
+package retroachievements_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	count := 501
+	resp, err := client.GetLeaderboardEntries(models.GetLeaderboardEntriesParameters{
+		Count: &count,
+	})
+	require.Nil(t, resp)
+	require.EqualError(t, err, "validating parameters: invalid parameters: LeaderboardID must be greater than 0, Count must not be greater than 500")
+	require.ErrorIs(t, err, retroachievements.ErrValidation)
+	validationErr := &retroachievements.ValidationError{}
+	require.ErrorAs(t, err, &validationErr)
+	require.Equal(t, []retroachievements.FieldError{
+		{Field: "LeaderboardID", Message: "must be greater than 0"},
+		{Field: "Count", Message: "must not be greater than 500"},
+	}, validationErr.Fields)
+	require.Zero(t, calls)
+}
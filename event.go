@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -9,8 +10,19 @@ import (
 )
 
 // GetAchievementOfTheWeek gets comprehensive metadata about the current Achievement of the Week.
+//
+// GetAchievementOfTheWeek uses context.Background internally; to specify the context, use GetAchievementOfTheWeekContext.
 func (c *Client) GetAchievementOfTheWeek(params models.GetAchievementOfTheWeekParameters) (*models.GetAchievementOfTheWeek, error) {
+	return c.GetAchievementOfTheWeekContext(context.Background(), params)
+}
+
+// GetAchievementOfTheWeekContext gets comprehensive metadata about the current Achievement of the Week.
+func (c *Client) GetAchievementOfTheWeekContext(ctx context.Context, params models.GetAchievementOfTheWeekParameters) (*models.GetAchievementOfTheWeek, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetAchievementOfTheWeek.php"),
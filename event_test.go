@@ -42,7 +42,7 @@ func TestGetAchievementOfTheWeek(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetAchievementOfTheWeek, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementOfTheWeek.php?y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementOfTheWeek.php?y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -0,0 +1,30 @@
+// Package getgameprogression provides an example for getting the median times players take to beat, complete and master a game, and to unlock each of its achievements.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+/*
+Test script, add RA_API_KEY to your env and use `go run getgameprogression.go`
+*/
+func main() {
+	secret := os.Getenv("RA_API_KEY")
+
+	client := retroachievements.NewClient(secret)
+
+	hardcore := true
+	resp, err := client.GetGameProgression(models.GetGameProgressionParameters{
+		GameID:   228,
+		Hardcore: &hardcore,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", resp)
+}
@@ -0,0 +1,35 @@
+package retroachievements
+
+import (
+	"expvar"
+)
+
+// ExpvarMetrics aggregates calls per endpoint and publishes them as an expvar variable
+type ExpvarMetrics struct {
+	registry *metricsRegistry
+}
+
+// NewExpvarMetrics publishes the metrics under name, using the latency histogram bucket bounds in seconds
+// or DefaultLatencyBuckets when none are given. Like expvar.Publish it panics if name is already in use.
+func NewExpvarMetrics(name string, buckets []float64) *ExpvarMetrics {
+	m := &ExpvarMetrics{
+		registry: newMetricsRegistry(buckets),
+	}
+	expvar.Publish(name, expvar.Func(func() any {
+		return map[string]any{
+			"latency_seconds_bounds": m.registry.buckets,
+			"endpoints":              m.registry.snapshot(),
+		}
+	}))
+	return m
+}
+
+// ObserveRequest adds a call to the endpoint totals
+func (m *ExpvarMetrics) ObserveRequest(metric RequestMetric) {
+	m.registry.ObserveRequest(metric)
+}
+
+// ObserveDecodeError adds a decode failure to the endpoint totals
+func (m *ExpvarMetrics) ObserveDecodeError(endpoint string) {
+	m.registry.ObserveDecodeError(endpoint)
+}
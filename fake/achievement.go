@@ -0,0 +1,73 @@
+package fake
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getAchievementUnlocks(caller *User, q url.Values) (any, error) {
+	id, err := requiredInt(q, "a")
+	if err != nil {
+		return nil, err
+	}
+	a := s.data.achievement(id)
+	if a == nil {
+		return nil, notFound()
+	}
+	game := s.data.gameInfo(a.GameID)
+	unlocks := s.data.achievementUnlocks(a.ID)
+	players, _ := s.data.players(a.GameID)
+	hardcore := 0
+	for _, u := range unlocks {
+		if u.Hardcore {
+			hardcore++
+		}
+	}
+	if flag(q, "h") {
+		unlocks = slices.DeleteFunc(slices.Clone(unlocks), func(u Unlock) bool {
+			return !u.Hardcore
+		})
+	}
+	page, err := paginate(q, unlocks, 50, 500)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetAchievementUnlocksUnlock{}
+	for _, u := range page {
+		points, softcore, _ := s.data.points(u.Username)
+		results = append(results, models.GetAchievementUnlocksUnlock{
+			User:             s.data.user(u.Username).Username,
+			RAPoints:         points,
+			RASoftcorePoints: softcore,
+			DateAwarded:      u.Date.UTC(),
+			HardcoreMode:     boolInt(u.Hardcore),
+		})
+	}
+	return models.GetAchievementUnlocks{
+		Achievement: models.GetAchievementUnlocksAchievement{
+			ID:           a.ID,
+			Title:        a.Title,
+			Description:  a.Description,
+			Points:       a.Points,
+			TrueRatio:    trueRatio(*a),
+			Author:       a.Author,
+			DateCreated:  models.DateTime{Time: a.DateCreated.UTC()},
+			DateModified: models.DateTime{Time: a.DateModified.UTC()},
+			Type:         stringOrNil(a.Type),
+		},
+		Console: models.GetAchievementUnlocksConsole{
+			ID:    game.ConsoleID,
+			Title: s.data.console(game.ConsoleID).Name,
+		},
+		Game: models.GetAchievementUnlocksGame{
+			ID:    game.ID,
+			Title: game.Title,
+		},
+		UnlocksCount:         len(unlocks),
+		UnlocksHardcoreCount: hardcore,
+		TotalPlayers:         len(players),
+		Unlocks:              results,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package fake
+
+import (
+	"cmp"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getComments(caller *User, q url.Values) (any, error) {
+	kind, err := requiredInt(q, "t")
+	if err != nil {
+		return nil, err
+	}
+	target, err := required(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	var match func(c Comment) bool
+	switch kind {
+	case 1, 2:
+		id, err := strconv.Atoi(target)
+		if err != nil {
+			return nil, invalid("i", "The i field must be an integer.")
+		}
+		if kind == 1 {
+			if s.data.game(id) == nil {
+				return nil, notFound()
+			}
+			match = func(c Comment) bool { return c.GameID == id }
+		} else {
+			if s.data.achievement(id) == nil {
+				return nil, notFound()
+			}
+			match = func(c Comment) bool { return c.AchievementID == id }
+		}
+	case 3:
+		if s.data.user(target) == nil {
+			return nil, notFound()
+		}
+		match = func(c Comment) bool { return c.Profile != "" && strings.EqualFold(c.Profile, target) }
+	default:
+		return nil, invalid("t", "The selected t is invalid.")
+	}
+	comments := []Comment{}
+	for _, c := range s.data.Comments {
+		if match(c) {
+			comments = append(comments, c)
+		}
+	}
+	newestFirst := false
+	switch q.Get("sort") {
+	case "", "submitted":
+	case "-submitted":
+		newestFirst = true
+	default:
+		return nil, invalid("sort", "The selected sort is invalid.")
+	}
+	slices.SortStableFunc(comments, func(a, b Comment) int {
+		if newestFirst {
+			return cmp.Compare(b.Submitted.UnixNano(), a.Submitted.UnixNano())
+		}
+		return cmp.Compare(a.Submitted.UnixNano(), b.Submitted.UnixNano())
+	})
+	page, err := paginate(q, comments, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetCommentsResult{}
+	for _, c := range page {
+		results = append(results, models.GetCommentsResult{
+			User:        c.Username,
+			Submitted:   c.Submitted.UTC(),
+			CommentText: c.Text,
+		})
+	}
+	return models.GetComments{
+		Count:   len(results),
+		Total:   len(comments),
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,624 @@
+package fake
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Award kinds given for a game
+const (
+	AwardBeatenSoftcore = "beaten-softcore"
+	AwardBeatenHardcore = "beaten-hardcore"
+	AwardCompleted      = "completed"
+	AwardMastered       = "mastered"
+)
+
+// Ticket states
+const (
+	TicketClosed = iota
+	TicketOpen
+	TicketResolved
+	TicketRequest
+)
+
+// Claim statuses
+const (
+	ClaimActive = iota
+	ClaimComplete
+	ClaimDropped
+)
+
+// Dataset holds everything the fake server knows about, every endpoint answers from it
+type Dataset struct {
+	Consoles           []Console
+	Users              []User
+	Games              []Game
+	Hashes             []Hash
+	Achievements       []Achievement
+	Unlocks            []Unlock
+	Leaderboards       []Leaderboard
+	LeaderboardEntries []LeaderboardEntry
+	Tickets            []Ticket
+	Claims             []Claim
+	Comments           []Comment
+
+	// ID of the achievement of the week
+	AchievementOfTheWeek int
+}
+
+// Console is a game system, or a pseudo system such as hubs and events
+type Console struct {
+	ID           int
+	Name         string
+	Active       bool
+	IsGameSystem bool
+}
+
+// User is a site member
+type User struct {
+	ID              int
+	Username        string
+	MemberSince     time.Time
+	Motto           string
+	RichPresenceMsg string
+
+	// Site permissions (0 = Normal, 1 = Jr. Dev, 2 = Developer, 3 = Moderator, 4 = Admin)
+	Permissions int
+
+	// Untracked users are left out of rankings
+	Untracked bool
+
+	// Web API key of the user, users without a key cannot call the API
+	APIKey string
+
+	// Usernames the user follows
+	Following []string
+
+	// IDs of the games on the user's want to play list
+	WantToPlay []int
+
+	// IDs of the games the user requested a set for
+	SetRequests []int
+}
+
+// Game is a game on a console
+type Game struct {
+	ID                int
+	Title             string
+	ConsoleID         int
+	ForumTopicID      int
+	Publisher         string
+	Developer         string
+	Genre             string
+	Released          time.Time
+	RichPresencePatch string
+	GuideURL          string
+	ParentGameID      int
+	Updated           time.Time
+}
+
+// Hash is a ROM hash supported by a game
+type Hash struct {
+	GameID   int
+	Name     string
+	MD5      string
+	Labels   []string
+	PatchURL string
+}
+
+// Achievement belongs to a game set, unofficial achievements are left out unless asked for
+type Achievement struct {
+	ID          int
+	GameID      int
+	Title       string
+	Description string
+	Points      int
+
+	// RetroPoints of the achievement, Points is used when zero
+	TrueRatio int
+
+	Author    string
+	BadgeName string
+
+	// Type of the achievement (empty, progression, win_condition, missable)
+	Type string
+
+	DisplayOrder int
+	MemAddr      string
+	Unofficial   bool
+	DateCreated  time.Time
+	DateModified time.Time
+}
+
+// Unlock is an achievement earned by a user, a hardcore unlock also counts as a softcore unlock
+type Unlock struct {
+	Username      string
+	AchievementID int
+	Hardcore      bool
+	Date          time.Time
+}
+
+// Leaderboard belongs to a game, entries are ranked lowest first when RankAsc is set
+type Leaderboard struct {
+	ID          int
+	GameID      int
+	Title       string
+	Description string
+
+	// Format of the scores (VALUE, SCORE, TIME, SECS, MILLISECS)
+	Format string
+
+	RankAsc bool
+}
+
+// LeaderboardEntry is the best score of a user on a leaderboard
+type LeaderboardEntry struct {
+	LeaderboardID int
+	Username      string
+	Score         int
+	Date          time.Time
+}
+
+// Ticket reports a problem with an achievement
+type Ticket struct {
+	ID            int
+	AchievementID int
+	ReportedBy    string
+
+	// Type of the report (1 = triggered at the wrong time, 2 = did not trigger)
+	ReportType int
+
+	// State of the ticket, one of the Ticket constants
+	State int
+
+	Hardcore   bool
+	Notes      string
+	ReportedAt time.Time
+	ResolvedAt time.Time
+	ResolvedBy string
+}
+
+// Claim is a developer working on a game set
+type Claim struct {
+	ID       int
+	Username string
+	GameID   int
+
+	// Type of the claim (0 = primary, 1 = collaboration)
+	ClaimType int
+
+	// Type of the set (0 = new set, 1 = revision)
+	SetType int
+
+	// Status of the claim, one of the Claim constants
+	Status int
+
+	Extension int
+	Special   int
+	Created   time.Time
+	Expires   time.Time
+	Updated   time.Time
+}
+
+// Comment is left on a game, an achievement or a user profile, exactly one of GameID, AchievementID and Profile is set
+type Comment struct {
+	GameID        int
+	AchievementID int
+	Profile       string
+	Username      string
+	Text          string
+	Submitted     time.Time
+}
+
+// award is a game award earned by a user
+type award struct {
+	gameID int
+	kind   string
+	date   time.Time
+}
+
+// progress sums up the achievements a user unlocked in a game
+type progress struct {
+	possible         int
+	possibleScore    int
+	achieved         int
+	score            int
+	achievedHardcore int
+	scoreHardcore    int
+	last             time.Time
+}
+
+func (d Dataset) clone() Dataset {
+	c := Dataset{
+		Consoles:             slices.Clone(d.Consoles),
+		Users:                slices.Clone(d.Users),
+		Games:                slices.Clone(d.Games),
+		Hashes:               slices.Clone(d.Hashes),
+		Achievements:         slices.Clone(d.Achievements),
+		Unlocks:              slices.Clone(d.Unlocks),
+		Leaderboards:         slices.Clone(d.Leaderboards),
+		LeaderboardEntries:   slices.Clone(d.LeaderboardEntries),
+		Tickets:              slices.Clone(d.Tickets),
+		Claims:               slices.Clone(d.Claims),
+		Comments:             slices.Clone(d.Comments),
+		AchievementOfTheWeek: d.AchievementOfTheWeek,
+	}
+	for i, u := range c.Users {
+		c.Users[i].Following = slices.Clone(u.Following)
+		c.Users[i].WantToPlay = slices.Clone(u.WantToPlay)
+		c.Users[i].SetRequests = slices.Clone(u.SetRequests)
+	}
+	for i, h := range c.Hashes {
+		c.Hashes[i].Labels = slices.Clone(h.Labels)
+	}
+	return c
+}
+
+func (d *Dataset) user(username string) *User {
+	for i := range d.Users {
+		if strings.EqualFold(d.Users[i].Username, username) {
+			return &d.Users[i]
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) apiKeyUser(key string) *User {
+	if key == "" {
+		return nil
+	}
+	for i := range d.Users {
+		if d.Users[i].APIKey == key {
+			return &d.Users[i]
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) console(id int) Console {
+	for _, c := range d.Consoles {
+		if c.ID == id {
+			return c
+		}
+	}
+	return Console{ID: id}
+}
+
+func (d *Dataset) game(id int) *Game {
+	for i := range d.Games {
+		if d.Games[i].ID == id {
+			return &d.Games[i]
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) achievement(id int) *Achievement {
+	for i := range d.Achievements {
+		if d.Achievements[i].ID == id {
+			return &d.Achievements[i]
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) leaderboard(id int) *Leaderboard {
+	for i := range d.Leaderboards {
+		if d.Leaderboards[i].ID == id {
+			return &d.Leaderboards[i]
+		}
+	}
+	return nil
+}
+
+// gameAchievements returns the official or unofficial achievements of a game in display order
+func (d *Dataset) gameAchievements(gameID int, unofficial bool) []Achievement {
+	achievements := []Achievement{}
+	for _, a := range d.Achievements {
+		if a.GameID == gameID && a.Unofficial == unofficial {
+			achievements = append(achievements, a)
+		}
+	}
+	slices.SortFunc(achievements, func(a, b Achievement) int {
+		return cmp.Or(cmp.Compare(a.DisplayOrder, b.DisplayOrder), cmp.Compare(a.ID, b.ID))
+	})
+	return achievements
+}
+
+// userUnlocks returns the unlocks of a user, most recent first
+func (d *Dataset) userUnlocks(username string) []Unlock {
+	unlocks := []Unlock{}
+	for _, u := range d.Unlocks {
+		if strings.EqualFold(u.Username, username) {
+			unlocks = append(unlocks, u)
+		}
+	}
+	sortUnlocks(unlocks)
+	return unlocks
+}
+
+// achievementUnlocks returns the unlocks of an achievement, most recent first
+func (d *Dataset) achievementUnlocks(achievementID int) []Unlock {
+	unlocks := []Unlock{}
+	for _, u := range d.Unlocks {
+		if u.AchievementID == achievementID {
+			unlocks = append(unlocks, u)
+		}
+	}
+	sortUnlocks(unlocks)
+	return unlocks
+}
+
+func sortUnlocks(unlocks []Unlock) {
+	slices.SortStableFunc(unlocks, func(a, b Unlock) int {
+		return cmp.Or(b.Date.Compare(a.Date), cmp.Compare(b.AchievementID, a.AchievementID))
+	})
+}
+
+// unlocked returns the unlocks of a user keyed by achievement ID
+func (d *Dataset) unlocked(username string) map[int]Unlock {
+	unlocked := map[int]Unlock{}
+	for _, u := range d.Unlocks {
+		if strings.EqualFold(u.Username, username) {
+			unlocked[u.AchievementID] = u
+		}
+	}
+	return unlocked
+}
+
+func trueRatio(a Achievement) int {
+	if a.TrueRatio == 0 {
+		return a.Points
+	}
+	return a.TrueRatio
+}
+
+// points sums the hardcore, softcore and RetroPoints a user earned from official achievements
+func (d *Dataset) points(username string) (hardcore int, softcore int, retro int) {
+	for _, u := range d.userUnlocks(username) {
+		a := d.achievement(u.AchievementID)
+		if a == nil || a.Unofficial {
+			continue
+		}
+		if u.Hardcore {
+			hardcore += a.Points
+			retro += trueRatio(*a)
+		} else {
+			softcore += a.Points
+		}
+	}
+	return hardcore, softcore, retro
+}
+
+// contributions counts the unlocks of achievements authored by a user and the points they awarded
+func (d *Dataset) contributions(username string) (count int, yield int) {
+	for _, u := range d.Unlocks {
+		a := d.achievement(u.AchievementID)
+		if a == nil || a.Unofficial || !strings.EqualFold(a.Author, username) || strings.EqualFold(u.Username, username) {
+			continue
+		}
+		count++
+		yield += a.Points
+	}
+	return count, yield
+}
+
+// progress sums up the official achievements a user unlocked in a game
+func (d *Dataset) progress(username string, gameID int) progress {
+	unlocked := d.unlocked(username)
+	p := progress{}
+	for _, a := range d.gameAchievements(gameID, false) {
+		p.possible++
+		p.possibleScore += a.Points
+		u, ok := unlocked[a.ID]
+		if !ok {
+			continue
+		}
+		p.achieved++
+		p.score += a.Points
+		if u.Hardcore {
+			p.achievedHardcore++
+			p.scoreHardcore += a.Points
+		}
+		if u.Date.After(p.last) {
+			p.last = u.Date
+		}
+	}
+	return p
+}
+
+// playedGames returns the IDs of the games a user unlocked achievements in, most recently played first
+func (d *Dataset) playedGames(username string) []int {
+	games := []int{}
+	for _, u := range d.userUnlocks(username) {
+		a := d.achievement(u.AchievementID)
+		if a != nil && !slices.Contains(games, a.GameID) {
+			games = append(games, a.GameID)
+		}
+	}
+	return games
+}
+
+// players returns the usernames of everyone who unlocked an achievement of a game, and of those who did in hardcore
+func (d *Dataset) players(gameID int) (all []string, hardcore []string) {
+	all, hardcore = []string{}, []string{}
+	for _, u := range d.Unlocks {
+		a := d.achievement(u.AchievementID)
+		if a == nil || a.GameID != gameID {
+			continue
+		}
+		if !containsFold(all, u.Username) {
+			all = append(all, u.Username)
+		}
+		if u.Hardcore && !containsFold(hardcore, u.Username) {
+			hardcore = append(hardcore, u.Username)
+		}
+	}
+	return all, hardcore
+}
+
+func containsFold(list []string, s string) bool {
+	return slices.ContainsFunc(list, func(item string) bool {
+		return strings.EqualFold(item, s)
+	})
+}
+
+// gameAwards returns the beaten and completion awards a user earned for a game
+func (d *Dataset) gameAwards(username string, gameID int) []award {
+	achievements := d.gameAchievements(gameID, false)
+	if len(achievements) == 0 {
+		return nil
+	}
+	unlocked := d.unlocked(username)
+	awards := []award{}
+
+	// a game is beaten once every progression achievement and one win condition achievement are unlocked
+	progression, winConditions := 0, 0
+	allProgression, won, beatenHardcore := true, false, true
+	var beatenDate time.Time
+	for _, a := range achievements {
+		switch a.Type {
+		case "progression":
+			progression++
+			u, ok := unlocked[a.ID]
+			if !ok {
+				allProgression = false
+				continue
+			}
+			beatenHardcore = beatenHardcore && u.Hardcore
+			if u.Date.After(beatenDate) {
+				beatenDate = u.Date
+			}
+		case "win_condition":
+			winConditions++
+			if u, ok := unlocked[a.ID]; ok && !won {
+				won = true
+				beatenHardcore = beatenHardcore && u.Hardcore
+				if u.Date.After(beatenDate) {
+					beatenDate = u.Date
+				}
+			}
+		}
+	}
+	beaten := progression+winConditions > 0 && allProgression && (winConditions == 0 || won)
+	if beaten {
+		kind := AwardBeatenSoftcore
+		if beatenHardcore {
+			kind = AwardBeatenHardcore
+		}
+		awards = append(awards, award{gameID: gameID, kind: kind, date: beatenDate})
+	}
+
+	completed, mastered := true, true
+	var completedDate time.Time
+	for _, a := range achievements {
+		u, ok := unlocked[a.ID]
+		if !ok {
+			completed, mastered = false, false
+			break
+		}
+		mastered = mastered && u.Hardcore
+		if u.Date.After(completedDate) {
+			completedDate = u.Date
+		}
+	}
+	if mastered {
+		awards = append(awards, award{gameID: gameID, kind: AwardMastered, date: completedDate})
+	} else if completed {
+		awards = append(awards, award{gameID: gameID, kind: AwardCompleted, date: completedDate})
+	}
+	return awards
+}
+
+// awards returns every game award a user earned, most recent first
+func (d *Dataset) awards(username string) []award {
+	awards := []award{}
+	for _, gameID := range d.playedGames(username) {
+		awards = append(awards, d.gameAwards(username, gameID)...)
+	}
+	slices.SortStableFunc(awards, func(a, b award) int {
+		return b.date.Compare(a.date)
+	})
+	return awards
+}
+
+var awardRanks = map[string]int{
+	AwardBeatenSoftcore: 1,
+	AwardBeatenHardcore: 2,
+	AwardCompleted:      3,
+	AwardMastered:       4,
+}
+
+// highestAward returns the best award a user earned for a game, nil if none
+func (d *Dataset) highestAward(username string, gameID int) *award {
+	var highest *award
+	for _, a := range d.gameAwards(username, gameID) {
+		if highest == nil || awardRanks[a.kind] > awardRanks[highest.kind] {
+			highest = &a
+		}
+	}
+	return highest
+}
+
+// rankedUsers returns the tracked users with points, best first
+func (d *Dataset) rankedUsers() []User {
+	users := []User{}
+	scores := map[string]int{}
+	for _, u := range d.Users {
+		if u.Untracked {
+			continue
+		}
+		hardcore, _, _ := d.points(u.Username)
+		if hardcore == 0 {
+			continue
+		}
+		scores[u.Username] = hardcore
+		users = append(users, u)
+	}
+	slices.SortStableFunc(users, func(a, b User) int {
+		return cmp.Or(cmp.Compare(scores[b.Username], scores[a.Username]), cmp.Compare(a.ID, b.ID))
+	})
+	return users
+}
+
+// better reports whether score ranks above other on the leaderboard
+func (lb *Leaderboard) better(score int, other int) bool {
+	if lb.RankAsc {
+		return score < other
+	}
+	return score > other
+}
+
+// rankedEntries returns the entries of a leaderboard, best first
+func (d *Dataset) rankedEntries(leaderboardID int) []LeaderboardEntry {
+	lb := d.leaderboard(leaderboardID)
+	entries := []LeaderboardEntry{}
+	for _, e := range d.LeaderboardEntries {
+		if e.LeaderboardID == leaderboardID {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b LeaderboardEntry) int {
+		switch {
+		case lb.better(a.Score, b.Score):
+			return -1
+		case lb.better(b.Score, a.Score):
+			return 1
+		}
+		return a.Date.Compare(b.Date)
+	})
+	return entries
+}
+
+// gameLeaderboards returns the leaderboards of a game
+func (d *Dataset) gameLeaderboards(gameID int) []Leaderboard {
+	leaderboards := []Leaderboard{}
+	for _, lb := range d.Leaderboards {
+		if lb.GameID == gameID {
+			leaderboards = append(leaderboards, lb)
+		}
+	}
+	return leaderboards
+}
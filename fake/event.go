@@ -0,0 +1,59 @@
+package fake
+
+import (
+	"net/url"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getAchievementOfTheWeek(caller *User, q url.Values) (any, error) {
+	a := s.data.achievement(s.data.AchievementOfTheWeek)
+	if a == nil {
+		return nil, notFound()
+	}
+	game := s.data.gameInfo(a.GameID)
+	unlocks := s.data.achievementUnlocks(a.ID)
+	players, _ := s.data.players(a.GameID)
+	hardcore := 0
+	results := []models.GetAchievementOfTheWeekUnlock{}
+	for _, u := range unlocks {
+		if u.Hardcore {
+			hardcore++
+		}
+		points, softcore, _ := s.data.points(u.Username)
+		results = append(results, models.GetAchievementOfTheWeekUnlock{
+			User:             s.data.user(u.Username).Username,
+			RAPoints:         points,
+			RASoftcorePoints: softcore,
+			DateAwarded:      u.Date.UTC(),
+			HardcoreMode:     boolInt(u.Hardcore),
+		})
+	}
+	return models.GetAchievementOfTheWeek{
+		Achievement: models.GetAchievementOfTheWeekAchievement{
+			ID:           a.ID,
+			Title:        a.Title,
+			Description:  a.Description,
+			Points:       a.Points,
+			TrueRatio:    trueRatio(*a),
+			Author:       a.Author,
+			BadgeName:    a.BadgeName,
+			BadgeURL:     badgeURL(a.BadgeName),
+			DateCreated:  dateOrNil(a.DateCreated),
+			DateModified: dateOrNil(a.DateModified),
+			Type:         stringOrNil(a.Type),
+		},
+		Console: models.GetAchievementOfTheWeekConsole{
+			ID:    game.ConsoleID,
+			Title: s.data.console(game.ConsoleID).Name,
+		},
+		Game: models.GetAchievementOfTheWeekGame{
+			ID:    game.ID,
+			Title: game.Title,
+		},
+		UnlocksCount:         len(unlocks),
+		UnlocksHardcoreCount: hardcore,
+		TotalPlayers:         len(players),
+		Unlocks:              results,
+	}, nil
+}
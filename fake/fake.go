@@ -0,0 +1,390 @@
+// Package fake provides an in-memory RetroAchievements web API server for end-to-end tests. It answers every
+// /API/API_*.php endpoint wrapped by the client from a single dataset, so changes such as a new unlock show up
+// consistently across the endpoints, for example:
+//
+//	server := fake.NewServer(fake.GenerateDataset(1))
+//	ts := httptest.NewServer(server)
+//	defer ts.Close()
+//	client := retroachievements.New(retroachievements.ClientConfig{
+//		Host:      ts.URL,
+//		APISecret: server.Dataset().Users[0].APIKey,
+//	})
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// ErrUnknownUser is returned when changing the dataset for a user that does not exist
+var ErrUnknownUser = errors.New("unknown user")
+
+// ErrUnknownAchievement is returned when unlocking an achievement that does not exist
+var ErrUnknownAchievement = errors.New("unknown achievement")
+
+// ErrUnknownLeaderboard is returned when submitting an entry to a leaderboard that does not exist
+var ErrUnknownLeaderboard = errors.New("unknown leaderboard")
+
+// Server answers the web API endpoints from an in-memory dataset, it is safe for concurrent use
+type Server struct {
+	// Now returns the current time, used for lookback windows, claim expirations and unlocks without a date
+	Now func() time.Time
+
+	mu   sync.RWMutex
+	data Dataset
+}
+
+// NewServer creates a server answering from a copy of the dataset
+func NewServer(data Dataset) *Server {
+	return &Server{
+		Now:  time.Now,
+		data: data.clone(),
+	}
+}
+
+// Dataset returns a copy of the data the server currently answers from
+func (s *Server) Dataset() Dataset {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.clone()
+}
+
+// Seed replaces the data the server answers from
+func (s *Server) Seed(data Dataset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = data.clone()
+}
+
+// Unlock awards an achievement to a user, unlocking a softcore achievement again in hardcore upgrades it.
+// A zero date is replaced by the current time.
+func (s *Server) Unlock(unlock Unlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data.user(unlock.Username) == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownUser, unlock.Username)
+	}
+	if s.data.achievement(unlock.AchievementID) == nil {
+		return fmt.Errorf("%w: %d", ErrUnknownAchievement, unlock.AchievementID)
+	}
+	if unlock.Date.IsZero() {
+		unlock.Date = s.Now()
+	}
+	for i, u := range s.data.Unlocks {
+		if strings.EqualFold(u.Username, unlock.Username) && u.AchievementID == unlock.AchievementID {
+			if unlock.Hardcore && !u.Hardcore {
+				s.data.Unlocks[i].Hardcore = true
+				s.data.Unlocks[i].Date = unlock.Date
+			}
+			return nil
+		}
+	}
+	s.data.Unlocks = append(s.data.Unlocks, unlock)
+	return nil
+}
+
+// SubmitEntry records a leaderboard score for a user, keeping only the best score of each user. A zero date
+// is replaced by the current time.
+func (s *Server) SubmitEntry(entry LeaderboardEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data.user(entry.Username) == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownUser, entry.Username)
+	}
+	lb := s.data.leaderboard(entry.LeaderboardID)
+	if lb == nil {
+		return fmt.Errorf("%w: %d", ErrUnknownLeaderboard, entry.LeaderboardID)
+	}
+	if entry.Date.IsZero() {
+		entry.Date = s.Now()
+	}
+	for i, e := range s.data.LeaderboardEntries {
+		if e.LeaderboardID == entry.LeaderboardID && strings.EqualFold(e.Username, entry.Username) {
+			if lb.better(entry.Score, e.Score) {
+				s.data.LeaderboardEntries[i] = entry
+			}
+			return nil
+		}
+	}
+	s.data.LeaderboardEntries = append(s.data.LeaderboardEntries, entry)
+	return nil
+}
+
+// handlerFunc answers an endpoint for the user owning the API key
+type handlerFunc func(s *Server, caller *User, q url.Values) (any, error)
+
+var routes = map[string]handlerFunc{
+	"/API/API_GetAchievementUnlocks.php":        (*Server).getAchievementUnlocks,
+	"/API/API_GetComments.php":                  (*Server).getComments,
+	"/API/API_GetAchievementOfTheWeek.php":      (*Server).getAchievementOfTheWeek,
+	"/API/API_GetRecentGameAwards.php":          (*Server).getRecentGameAwards,
+	"/API/API_GetActiveClaims.php":              (*Server).getActiveClaims,
+	"/API/API_GetClaims.php":                    (*Server).getClaims,
+	"/API/API_GetTopTenUsers.php":               (*Server).getTopTenUsers,
+	"/API/API_GetGame.php":                      (*Server).getGame,
+	"/API/API_GetGameExtended.php":              (*Server).getGameExtended,
+	"/API/API_GetGameHashes.php":                (*Server).getGameHashes,
+	"/API/API_GetAchievementCount.php":          (*Server).getAchievementCount,
+	"/API/API_GetAchievementDistribution.php":   (*Server).getAchievementDistribution,
+	"/API/API_GetGameRankAndScore.php":          (*Server).getGameRankAndScore,
+	"/API/API_GetGameProgression.php":           (*Server).getGameProgression,
+	"/API/API_GetGameLeaderboards.php":          (*Server).getGameLeaderboards,
+	"/API/API_GetLeaderboardEntries.php":        (*Server).getLeaderboardEntries,
+	"/API/API_GetUserGameLeaderboards.php":      (*Server).getUserGameLeaderboards,
+	"/API/API_GetConsoleIDs.php":                (*Server).getConsoleIDs,
+	"/API/API_GetGameList.php":                  (*Server).getGameList,
+	"/API/API_GetTicketData.php":                (*Server).getTicketData,
+	"/API/API_GetUserProfile.php":               (*Server).getUserProfile,
+	"/API/API_GetUserRecentAchievements.php":    (*Server).getUserRecentAchievements,
+	"/API/API_GetAchievementsEarnedBetween.php": (*Server).getAchievementsEarnedBetween,
+	"/API/API_GetAchievementsEarnedOnDay.php":   (*Server).getAchievementsEarnedOnDay,
+	"/API/API_GetGameInfoAndUserProgress.php":   (*Server).getGameInfoAndUserProgress,
+	"/API/API_GetUserCompletionProgress.php":    (*Server).getUserCompletionProgress,
+	"/API/API_GetUserAwards.php":                (*Server).getUserAwards,
+	"/API/API_GetUserClaims.php":                (*Server).getUserClaims,
+	"/API/API_GetUserGameRankAndScore.php":      (*Server).getUserGameRankAndScore,
+	"/API/API_GetUserPoints.php":                (*Server).getUserPoints,
+	"/API/API_GetUserProgress.php":              (*Server).getUserProgress,
+	"/API/API_GetUserRecentlyPlayedGames.php":   (*Server).getUserRecentlyPlayedGames,
+	"/API/API_GetUserSummary.php":               (*Server).getUserSummary,
+	"/API/API_GetUserCompletedGames.php":        (*Server).getUserCompletedGames,
+	"/API/API_GetUserWantToPlayList.php":        (*Server).getUserWantToPlayList,
+	"/API/API_GetUsersIFollow.php":              (*Server).getUsersIFollow,
+	"/API/API_GetUsersFollowingMe.php":          (*Server).getUsersFollowingMe,
+	"/API/API_GetUserSetRequests.php":           (*Server).getUserSetRequests,
+}
+
+// ServeHTTP checks the API key and answers the endpoint with JSON
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, ok := routes[r.URL.Path]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, models.ErrorResponse{
+			Message: "Not Found",
+		})
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, models.ErrorResponse{
+			Message: "Method Not Allowed",
+		})
+		return
+	}
+	q := r.URL.Query()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	caller := s.data.apiKeyUser(q.Get("y"))
+	if caller == nil {
+		writeJSON(w, http.StatusUnauthorized, models.ErrorResponse{
+			Message: "Unauthenticated.",
+			Errors: []models.ErrorDetail{
+				{
+					Status: http.StatusUnauthorized,
+					Code:   "unauthorized",
+					Title:  "Not Authorized",
+				},
+			},
+		})
+		return
+	}
+	resp, err := handler(s, caller, q)
+	if err != nil {
+		var apiErr *apiError
+		if errors.As(err, &apiErr) {
+			writeJSON(w, apiErr.status, apiErr.body)
+			return
+		}
+		writeJSON(w, http.StatusInternalServerError, models.ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// apiError is an error answer of the API
+type apiError struct {
+	status int
+	body   any
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status %d", e.status)
+}
+
+func notFound() error {
+	return &apiError{
+		status: http.StatusNotFound,
+		body: models.ErrorResponse{
+			Message: "Not Found",
+		},
+	}
+}
+
+func invalid(field string, message string) error {
+	return &apiError{
+		status: http.StatusUnprocessableEntity,
+		body: models.UnprocessableErrorResponse{
+			Message: message,
+			Errors: map[string][]string{
+				field: {message},
+			},
+		},
+	}
+}
+
+// required reads a query parameter that must be set
+func required(q url.Values, field string) (string, error) {
+	value := q.Get(field)
+	if value == "" {
+		return "", invalid(field, fmt.Sprintf("The %s field is required.", field))
+	}
+	return value, nil
+}
+
+// requiredInt reads a query parameter that must be set to a number
+func requiredInt(q url.Values, field string) (int, error) {
+	value, err := required(q, field)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, invalid(field, fmt.Sprintf("The %s field must be an integer.", field))
+	}
+	return n, nil
+}
+
+// optionalInt reads a number query parameter, falling back to def when it is not set
+func optionalInt(q url.Values, field string, def int) (int, error) {
+	if q.Get(field) == "" {
+		return def, nil
+	}
+	return requiredInt(q, field)
+}
+
+// flag reports whether a query parameter is set to 1
+func flag(q url.Values, field string) bool {
+	return q.Get(field) == "1"
+}
+
+// paginate applies the c and o query parameters to items, using def records when no count is given
+func paginate[T any](q url.Values, items []T, def int, max int) ([]T, error) {
+	count, err := optionalInt(q, "c", def)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := optionalInt(q, "o", 0)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, invalid("c", "The c field must be at least 0.")
+	}
+	if max > 0 && count > max {
+		return nil, invalid("c", fmt.Sprintf("The c field must not be greater than %d.", max))
+	}
+	if offset < 0 {
+		return nil, invalid("o", "The o field must be at least 0.")
+	}
+	if offset >= len(items) {
+		return []T{}, nil
+	}
+	end := min(offset+count, len(items))
+	return slices.Clone(items[offset:end]), nil
+}
+
+// requiredUser reads the u query parameter and looks up the user
+func (s *Server) requiredUser(q url.Values) (*User, error) {
+	username, err := required(q, "u")
+	if err != nil {
+		return nil, err
+	}
+	user := s.data.user(username)
+	if user == nil {
+		return nil, notFound()
+	}
+	return user, nil
+}
+
+// requiredGame reads a game ID query parameter and looks up the game
+func (s *Server) requiredGame(q url.Values, field string) (*Game, error) {
+	id, err := requiredInt(q, field)
+	if err != nil {
+		return nil, err
+	}
+	game := s.data.game(id)
+	if game == nil {
+		return nil, notFound()
+	}
+	return game, nil
+}
+
+const (
+	siteURL   = "https://retroachievements.org"
+	staticURL = "https://static.retroachievements.org"
+)
+
+// gameInfo returns the game with the ID, or a game holding only the ID if it does not exist
+func (d *Dataset) gameInfo(id int) Game {
+	if game := d.game(id); game != nil {
+		return *game
+	}
+	return Game{ID: id}
+}
+
+// gameImage returns the path of one of the four images of a game: icon, title screen, in game screen and box art
+func gameImage(gameID int, kind int) string {
+	return fmt.Sprintf("/Images/%06d.png", gameID*4+kind)
+}
+
+func badgeURL(badgeName string) string {
+	return fmt.Sprintf("/Badge/%s.png", badgeName)
+}
+
+func userPic(username string) string {
+	return fmt.Sprintf("/UserPic/%s.png", username)
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func intOrNil(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func dateOrNil(t time.Time) *models.DateOnly {
+	if t.IsZero() {
+		return nil
+	}
+	return &models.DateOnly{Time: t.UTC()}
+}
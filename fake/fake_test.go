@@ -0,0 +1,515 @@
+package fake_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/fake"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+var now = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func dataset() fake.Dataset {
+	return fake.Dataset{
+		Consoles: []fake.Console{
+			{ID: 7, Name: "NES/Famicom", Active: true, IsGameSystem: true},
+		},
+		Users: []fake.User{
+			{ID: 1, Username: "Dev", APIKey: "dev_key", Permissions: 2},
+			{ID: 2, Username: "Player", APIKey: "player_key", Following: []string{"Dev"}},
+			{ID: 3, Username: "Rival"},
+		},
+		Games: []fake.Game{
+			{ID: 1, Title: "Test Game", ConsoleID: 7},
+		},
+		Achievements: []fake.Achievement{
+			{ID: 1, GameID: 1, Title: "First", Points: 5, Author: "Dev", Type: "progression", DisplayOrder: 1},
+			{ID: 2, GameID: 1, Title: "Second", Points: 10, Author: "Dev", Type: "win_condition", DisplayOrder: 2},
+		},
+		Unlocks: []fake.Unlock{
+			{Username: "Rival", AchievementID: 1, Hardcore: true, Date: now.Add(-3 * time.Hour)},
+			{Username: "Dev", AchievementID: 1, Date: now.Add(-2 * time.Hour)},
+		},
+		Leaderboards: []fake.Leaderboard{
+			{ID: 1, GameID: 1, Title: "Speedrun", Format: "VALUE", RankAsc: true},
+		},
+		LeaderboardEntries: []fake.LeaderboardEntry{
+			{LeaderboardID: 1, Username: "Dev", Score: 300, Date: now},
+			{LeaderboardID: 1, Username: "Rival", Score: 100, Date: now},
+			{LeaderboardID: 1, Username: "Player", Score: 200, Date: now},
+		},
+	}
+}
+
+func newClient(t *testing.T, server *fake.Server, key string) *retroachievements.Client {
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	return retroachievements.New(retroachievements.ClientConfig{
+		Host:      ts.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: key,
+	})
+}
+
+func TestUnlockConsistency(t *testing.T) {
+	server := fake.NewServer(dataset())
+	server.Now = func() time.Time { return now }
+	client := newClient(t, server, "player_key")
+
+	progress, err := client.GetUserProgress(models.GetUserProgressParameters{
+		Username: "Player",
+		GameIDs:  []int{1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, (*progress)["1"].NumAchieved)
+
+	require.NoError(t, server.Unlock(fake.Unlock{
+		Username:      "Player",
+		AchievementID: 2,
+		Hardcore:      true,
+	}))
+
+	progress, err = client.GetUserProgress(models.GetUserProgressParameters{
+		Username: "Player",
+		GameIDs:  []int{1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.GetUserProgress{
+		NumPossibleAchievements: 2,
+		PossibleScore:           15,
+		NumAchieved:             1,
+		ScoreAchieved:           10,
+		NumAchievedHardcore:     1,
+		ScoreAchievedHardcore:   10,
+	}, (*progress)["1"])
+
+	info, err := client.GetGameInfoAndUserProgress(models.GetGameInfoAndUserProgressParameters{
+		Username: "Player",
+		GameID:   1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, info.NumAwardedToUserHardcore)
+	require.Equal(t, "50.00%", info.UserCompletion)
+	require.Equal(t, 3, info.NumDistinctPlayers)
+	require.Equal(t, now, info.Achievements[2].DateEarnedHardcore.Time)
+	require.Nil(t, info.Achievements[1].DateEarned)
+
+	unlocks, err := client.GetAchievementUnlocks(models.GetAchievementUnlocksParameters{
+		AchievementID: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, unlocks.UnlocksCount)
+	require.Equal(t, 1, unlocks.UnlocksHardcoreCount)
+	require.Equal(t, 3, unlocks.TotalPlayers)
+	require.Equal(t, "Player", unlocks.Unlocks[0].User)
+	require.Equal(t, 10, unlocks.Unlocks[0].RAPoints)
+
+	points, err := client.GetUserPoints(models.GetUserPointsParameters{
+		Username: "Player",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &models.GetUserPoints{Points: 10}, points)
+
+	require.NoError(t, server.Unlock(fake.Unlock{
+		Username:      "Player",
+		AchievementID: 1,
+		Hardcore:      true,
+	}))
+	awards, err := client.GetUserAwards(models.GetUserAwardsParameters{
+		Username: "Player",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, awards.MasteryAwardsCount)
+	require.Equal(t, 1, awards.BeatenHardcoreAwardsCount)
+}
+
+func TestUnlockUnknown(t *testing.T) {
+	server := fake.NewServer(dataset())
+	require.ErrorIs(t, server.Unlock(fake.Unlock{Username: "Nobody", AchievementID: 1}), fake.ErrUnknownUser)
+	require.ErrorIs(t, server.Unlock(fake.Unlock{Username: "Player", AchievementID: 99}), fake.ErrUnknownAchievement)
+}
+
+func TestSubmitEntry(t *testing.T) {
+	server := fake.NewServer(dataset())
+	client := newClient(t, server, "player_key")
+	require.NoError(t, server.SubmitEntry(fake.LeaderboardEntry{
+		LeaderboardID: 1,
+		Username:      "Player",
+		Score:         50,
+	}))
+	// a worse score does not replace the best one
+	require.NoError(t, server.SubmitEntry(fake.LeaderboardEntry{
+		LeaderboardID: 1,
+		Username:      "Player",
+		Score:         500,
+	}))
+	leaderboards, err := client.GetGameLeaderboards(models.GetGameLeaderboardsParameters{
+		GameID: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &models.GetGameLeaderboardsTopEntry{
+		User:           "Player",
+		Score:          50,
+		FormattedScore: "50",
+	}, leaderboards.Results[0].TopEntry)
+}
+
+func TestPagination(t *testing.T) {
+	server := fake.NewServer(dataset())
+	client := newClient(t, server, "player_key")
+	tests := []struct {
+		name   string
+		count  *int
+		offset *int
+		ranks  []int
+		users  []string
+	}{
+		{
+			name:  "default",
+			ranks: []int{1, 2, 3},
+			users: []string{"Rival", "Player", "Dev"},
+		},
+		{
+			name:  "count",
+			count: ptr(2),
+			ranks: []int{1, 2},
+			users: []string{"Rival", "Player"},
+		},
+		{
+			name:   "offset",
+			count:  ptr(1),
+			offset: ptr(1),
+			ranks:  []int{2},
+			users:  []string{"Player"},
+		},
+		{
+			name:   "past the end",
+			offset: ptr(5),
+			ranks:  []int{},
+			users:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := client.GetLeaderboardEntries(models.GetLeaderboardEntriesParameters{
+				LeaderboardID: 1,
+				Count:         tt.count,
+				Offset:        tt.offset,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 3, entries.Total)
+			require.Equal(t, len(tt.users), entries.Count)
+			ranks, users := []int{}, []string{}
+			for _, e := range entries.Results {
+				ranks = append(ranks, e.Rank)
+				users = append(users, e.User)
+			}
+			require.Equal(t, tt.ranks, ranks)
+			require.Equal(t, tt.users, users)
+		})
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{
+			name: "missing",
+			key:  "",
+		},
+		{
+			name: "unknown",
+			key:  "wrong_key",
+		},
+		{
+			name: "user without key",
+			key:  "Rival",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newClient(t, fake.NewServer(dataset()), tt.key)
+			resp, err := client.GetGame(models.GetGameParameters{
+				GameID: 1,
+			})
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	client := newClient(t, fake.NewServer(dataset()), "player_key")
+
+	game, err := client.GetGame(models.GetGameParameters{
+		GameID: 99,
+	})
+	require.NoError(t, err)
+	require.Nil(t, game)
+
+	_, err = retroachievements.Call[models.GetUserProfile](context.Background(), client, "/API/API_GetUserProfile.php", nil)
+	require.ErrorIs(t, err, retroachievements.ErrValidation)
+	apiErr := &retroachievements.APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, map[string][]string{
+		"u": {"The u field is required."},
+	}, apiErr.FieldErrors)
+
+	_, err = client.GetLeaderboardEntries(models.GetLeaderboardEntriesParameters{
+		LeaderboardID: 1,
+		Count:         ptr(501),
+	})
+	require.ErrorIs(t, err, retroachievements.ErrValidation)
+}
+
+func TestFollows(t *testing.T) {
+	server := fake.NewServer(dataset())
+	following, err := newClient(t, server, "player_key").GetUsersIFollow(models.GetUsersIFollowParameters{})
+	require.NoError(t, err)
+	require.Equal(t, &models.GetUsersIFollow{
+		Count: 1,
+		Total: 1,
+		Results: []models.GetUsersIFollowResult{
+			{User: "Dev", PointsSoftcore: 5},
+		},
+	}, following)
+
+	followers, err := newClient(t, server, "dev_key").GetUsersFollowingMe(models.GetUsersFollowingMeParameters{})
+	require.NoError(t, err)
+	require.Equal(t, &models.GetUsersFollowingMe{
+		Count: 1,
+		Total: 1,
+		Results: []models.GetUsersFollowingMeResult{
+			{User: "Player"},
+		},
+	}, followers)
+}
+
+func TestGenerateDataset(t *testing.T) {
+	require.Equal(t, fake.GenerateDataset(1), fake.GenerateDataset(1))
+	require.NotEqual(t, fake.GenerateDataset(1), fake.GenerateDataset(2))
+}
+
+func TestEveryEndpoint(t *testing.T) {
+	data := fake.GenerateDataset(1)
+	server := fake.NewServer(data)
+	server.Now = func() time.Time { return fake.GeneratedAt }
+	client := newClient(t, server, data.Users[0].APIKey)
+	username := data.Users[1].Username
+	gameID := data.Achievements[0].GameID
+	achievementID := data.Achievements[0].ID
+	leaderboardID := data.Leaderboards[0].ID
+	day := data.Unlocks[0].Date
+
+	tests := []struct {
+		name string
+		call func() (any, error)
+	}{
+		{"GetAchievementUnlocks", func() (any, error) {
+			return client.GetAchievementUnlocks(models.GetAchievementUnlocksParameters{AchievementID: achievementID})
+		}},
+		{"GetComments", func() (any, error) {
+			return client.GetComments(models.GetCommentsParameters{Type: models.GetCommentsGame{GameID: gameID}})
+		}},
+		{"GetAchievementOfTheWeek", func() (any, error) {
+			return client.GetAchievementOfTheWeek(models.GetAchievementOfTheWeekParameters{})
+		}},
+		{"GetRecentGameAwards", func() (any, error) {
+			return client.GetRecentGameAwards(models.GetRecentGameAwardsParameters{})
+		}},
+		{"GetActiveClaims", func() (any, error) {
+			return client.GetActiveClaims(models.GetActiveClaimsParameters{})
+		}},
+		{"GetClaims", func() (any, error) {
+			return client.GetClaims(models.GetClaimsParameters{Kind: &models.GetClaimsParametersKindDropped{}})
+		}},
+		{"GetTopTenUsers", func() (any, error) {
+			return client.GetTopTenUsers(models.GetTopTenUsersParameters{})
+		}},
+		{"GetGame", func() (any, error) {
+			return client.GetGame(models.GetGameParameters{GameID: gameID})
+		}},
+		{"GetGameExtended", func() (any, error) {
+			return client.GetGameExtended(models.GetGameExtentedParameters{GameID: gameID, Unofficial: ptr(true)})
+		}},
+		{"GetGameHashes", func() (any, error) {
+			return client.GetGameHashes(models.GetGameHashesParameters{GameID: gameID})
+		}},
+		{"GetAchievementCount", func() (any, error) {
+			return client.GetAchievementCount(models.GetAchievementCountParameters{GameID: gameID})
+		}},
+		{"GetAchievementDistribution", func() (any, error) {
+			return client.GetAchievementDistribution(models.GetAchievementDistributionParameters{GameID: gameID, Hardcore: ptr(true)})
+		}},
+		{"GetGameRankAndScore", func() (any, error) {
+			return client.GetGameRankAndScore(models.GetGameRankAndScoreParameters{GameID: gameID, LatestMasters: ptr(true)})
+		}},
+		{"GetGameProgression", func() (any, error) {
+			return client.GetGameProgression(models.GetGameProgressionParameters{GameID: gameID, Hardcore: ptr(true)})
+		}},
+		{"GetGameLeaderboards", func() (any, error) {
+			return client.GetGameLeaderboards(models.GetGameLeaderboardsParameters{GameID: gameID})
+		}},
+		{"GetLeaderboardEntries", func() (any, error) {
+			return client.GetLeaderboardEntries(models.GetLeaderboardEntriesParameters{LeaderboardID: leaderboardID})
+		}},
+		{"GetUserGameLeaderboards", func() (any, error) {
+			return client.GetUserGameLeaderboards(models.GetUserGameLeaderboardsParameters{Username: username, GameID: gameID})
+		}},
+		{"GetConsoleIDs", func() (any, error) {
+			return client.GetConsoleIDs(models.GetConsoleIDsParameters{OnlyActive: ptr(true)})
+		}},
+		{"GetGameList", func() (any, error) {
+			return client.GetGameList(models.GetGameListParameters{SystemID: data.Games[0].ConsoleID, IncludeHashes: ptr(true)})
+		}},
+		{"GetTicketByID", func() (any, error) {
+			return client.GetTicketByID(models.GetTicketByIDParameters{TicketID: data.Tickets[0].ID})
+		}},
+		{"GetMostTicketedGames", func() (any, error) {
+			return client.GetMostTicketedGames(models.GetMostTicketedGamesParameters{})
+		}},
+		{"GetMostRecentTickets", func() (any, error) {
+			return client.GetMostRecentTickets(models.GetMostRecentTicketsParameters{})
+		}},
+		{"GetGameTicketStats", func() (any, error) {
+			return client.GetGameTicketStats(models.GetGameTicketStatsParameters{GameID: gameID, IncludeTicketMetadata: ptr(true)})
+		}},
+		{"GetDeveloperTicketStats", func() (any, error) {
+			return client.GetDeveloperTicketStats(models.GetDeveloperTicketStatsParameters{Username: data.Users[0].Username})
+		}},
+		{"GetAchievementTicketStats", func() (any, error) {
+			return client.GetAchievementTicketStats(models.GetAchievementTicketStatsParameters{AchievementID: achievementID})
+		}},
+		{"GetUserProfile", func() (any, error) {
+			return client.GetUserProfile(models.GetUserProfileParameters{Username: username})
+		}},
+		{"GetUserRecentAchievements", func() (any, error) {
+			return client.GetUserRecentAchievements(models.GetUserRecentAchievementsParameters{Username: username, LookbackMinutes: ptr(60 * 24 * 365)})
+		}},
+		{"GetAchievementsEarnedBetween", func() (any, error) {
+			return client.GetAchievementsEarnedBetween(models.GetAchievementsEarnedBetweenParameters{Username: username, From: day.AddDate(0, -1, 0), To: day})
+		}},
+		{"GetAchievementsEarnedOnDay", func() (any, error) {
+			return client.GetAchievementsEarnedOnDay(models.GetAchievementsEarnedOnDayParameters{Username: username, Date: day})
+		}},
+		{"GetGameInfoAndUserProgress", func() (any, error) {
+			return client.GetGameInfoAndUserProgress(models.GetGameInfoAndUserProgressParameters{Username: username, GameID: gameID, IncludeAwardMetadata: ptr(true)})
+		}},
+		{"GetUserCompletionProgress", func() (any, error) {
+			return client.GetUserCompletionProgress(models.GetUserCompletionProgressParameters{Username: username})
+		}},
+		{"GetUserAwards", func() (any, error) {
+			return client.GetUserAwards(models.GetUserAwardsParameters{Username: username})
+		}},
+		{"GetUserClaims", func() (any, error) {
+			return client.GetUserClaims(models.GetUserClaimsParameters{Username: data.Users[0].Username})
+		}},
+		{"GetUserGameRankAndScore", func() (any, error) {
+			return client.GetUserGameRankAndScore(models.GetUserGameRankAndScoreParameters{Username: username, GameID: gameID})
+		}},
+		{"GetUserPoints", func() (any, error) {
+			return client.GetUserPoints(models.GetUserPointsParameters{Username: username})
+		}},
+		{"GetUserProgress", func() (any, error) {
+			return client.GetUserProgress(models.GetUserProgressParameters{Username: username, GameIDs: []int{1, 2, 3}})
+		}},
+		{"GetUserRecentlyPlayedGames", func() (any, error) {
+			return client.GetUserRecentlyPlayedGames(models.GetUserRecentlyPlayedGamesParameters{Username: username})
+		}},
+		{"GetUserSummary", func() (any, error) {
+			return client.GetUserSummary(models.GetUserSummaryParameters{Username: username, GamesCount: ptr(3)})
+		}},
+		{"GetUserCompletedGames", func() (any, error) {
+			return client.GetUserCompletedGames(models.GetUserCompletedGamesParameters{Username: username})
+		}},
+		{"GetUserWantToPlayList", func() (any, error) {
+			return client.GetUserWantToPlayList(models.GetUserWantToPlayListParameters{Username: username})
+		}},
+		{"GetUsersIFollow", func() (any, error) {
+			return client.GetUsersIFollow(models.GetUsersIFollowParameters{})
+		}},
+		{"GetUsersFollowingMe", func() (any, error) {
+			return client.GetUsersFollowingMe(models.GetUsersFollowingMeParameters{})
+		}},
+		{"GetUserSetRequests", func() (any, error) {
+			return client.GetUserSetRequests(models.GetUserSetRequestsParameters{Username: username, All: ptr(true)})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.call()
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestOptionalFilters(t *testing.T) {
+	data := dataset()
+	data.Comments = []fake.Comment{
+		{Username: "Player", GameID: 1, Text: "older", Submitted: now.Add(-time.Hour)},
+		{Username: "Rival", GameID: 1, Text: "newer", Submitted: now},
+	}
+	client := newClient(t, fake.NewServer(data), "player_key")
+
+	unlocks, err := client.GetAchievementUnlocks(models.GetAchievementUnlocksParameters{
+		AchievementID: 1,
+		Hardcore:      ptr(true),
+	})
+	require.NoError(t, err)
+	require.Len(t, unlocks.Unlocks, 1)
+	require.Equal(t, "Rival", unlocks.Unlocks[0].User)
+
+	for newestFirst, expected := range map[bool][]string{false: {"older", "newer"}, true: {"newer", "older"}} {
+		comments, err := client.GetComments(models.GetCommentsParameters{
+			Type:        models.GetCommentsGame{GameID: 1},
+			NewestFirst: &newestFirst,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, []string{comments.Results[0].CommentText, comments.Results[1].CommentText})
+	}
+
+	progress, err := client.GetUserCompletionProgress(models.GetUserCompletionProgressParameters{
+		Username: "Dev",
+		Count:    ptr(1),
+		Offset:   ptr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, progress.Total)
+	require.Empty(t, progress.Results)
+}
+
+func TestGameProgression(t *testing.T) {
+	data := dataset()
+	data.Unlocks = append(data.Unlocks, fake.Unlock{Username: "Rival", AchievementID: 2, Hardcore: true, Date: now.Add(-time.Hour)})
+	client := newClient(t, fake.NewServer(data), "player_key")
+
+	progression, err := client.GetGameProgression(models.GetGameProgressionParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, progression.NumDistinctPlayers)
+	require.Equal(t, 1, progression.TimesUsedInHardcoreBeatMedian)
+	require.Equal(t, ptr(7200), progression.MedianTimeToBeatHardcore)
+	require.Equal(t, ptr(7200), progression.MedianTimeToMaster)
+	require.Nil(t, progression.MedianTimeToBeat)
+	require.Len(t, progression.Achievements, 2)
+	require.Equal(t, 2, progression.Achievements[0].TimesUsedInUnlockMedian)
+	require.Equal(t, 1, progression.Achievements[0].TimesUsedInHardcoreUnlockMedian)
+	require.Equal(t, ptr(0), progression.Achievements[0].MedianTimeToUnlock)
+	require.Equal(t, ptr(7200), progression.Achievements[1].MedianTimeToUnlockHardcore)
+
+	hardcore, err := client.GetGameProgression(models.GetGameProgressionParameters{GameID: 1, Hardcore: ptr(true)})
+	require.NoError(t, err)
+	require.Equal(t, 1, hardcore.NumDistinctPlayers)
+	require.Equal(t, 1, hardcore.Achievements[0].TimesUsedInUnlockMedian)
+}
@@ -0,0 +1,155 @@
+package fake
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getRecentGameAwards(caller *User, q url.Values) (any, error) {
+	until := s.Now()
+	if d := q.Get("d"); d != "" {
+		date, err := time.Parse(time.DateOnly, d)
+		if err != nil {
+			return nil, invalid("d", "The d field must match the format Y-m-d.")
+		}
+		until = date.AddDate(0, 0, 1)
+	}
+	kinds := []string{}
+	if k := q.Get("k"); k != "" {
+		kinds = strings.Split(k, ",")
+	}
+	type userAward struct {
+		award
+		username string
+	}
+	awards := []userAward{}
+	for _, u := range s.data.Users {
+		for _, a := range s.data.awards(u.Username) {
+			if !a.date.Before(until) || (len(kinds) > 0 && !slices.Contains(kinds, a.kind)) {
+				continue
+			}
+			awards = append(awards, userAward{award: a, username: u.Username})
+		}
+	}
+	slices.SortStableFunc(awards, func(a, b userAward) int {
+		return b.date.Compare(a.date)
+	})
+	page, err := paginate(q, awards, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetRecentGameAwardsResult{}
+	for _, a := range page {
+		game := s.data.gameInfo(a.gameID)
+		results = append(results, models.GetRecentGameAwardsResult{
+			User:        a.username,
+			AwardKind:   a.kind,
+			AwardDate:   models.RFC3339NumColonTZ{Time: a.date.UTC()},
+			GameID:      game.ID,
+			GameTitle:   game.Title,
+			ConsoleID:   game.ConsoleID,
+			ConsoleName: s.data.console(game.ConsoleID).Name,
+		})
+	}
+	return models.GetRecentGameAwards{
+		Count:   len(results),
+		Total:   len(awards),
+		Results: results,
+	}, nil
+}
+
+// claim converts a claim to the API answer, every claim endpoint answers with the same fields
+func (s *Server) claim(c Claim) models.GetClaims {
+	game := s.data.gameInfo(c.GameID)
+	jrDev := 0
+	if u := s.data.user(c.Username); u != nil && u.Permissions == 1 {
+		jrDev = 1
+	}
+	minutesLeft := 0
+	if c.Status == ClaimActive {
+		minutesLeft = max(0, int(c.Expires.Sub(s.Now()).Minutes()))
+	}
+	return models.GetClaims{
+		ID:          c.ID,
+		User:        c.Username,
+		GameID:      game.ID,
+		GameTitle:   game.Title,
+		GameIcon:    gameImage(game.ID, 0),
+		ConsoleID:   game.ConsoleID,
+		ConsoleName: s.data.console(game.ConsoleID).Name,
+		ClaimType:   c.ClaimType,
+		SetType:     c.SetType,
+		Status:      c.Status,
+		Extension:   c.Extension,
+		Special:     c.Special,
+		Created:     models.DateTime{Time: c.Created.UTC()},
+		DoneTime:    models.DateTime{Time: c.Expires.UTC()},
+		Updated:     models.DateTime{Time: c.Updated.UTC()},
+		UserIsJrDev: jrDev,
+		MinutesLeft: minutesLeft,
+	}
+}
+
+// activeClaim reports whether a claim is still being worked on
+func (s *Server) activeClaim(c Claim) bool {
+	return c.Status == ClaimActive && c.Expires.After(s.Now())
+}
+
+func (s *Server) getActiveClaims(caller *User, q url.Values) (any, error) {
+	claims := []models.GetActiveClaims{}
+	for _, c := range s.data.Claims {
+		if s.activeClaim(c) {
+			claims = append(claims, models.GetActiveClaims(s.claim(c)))
+		}
+	}
+	return claims, nil
+}
+
+func (s *Server) getClaims(caller *User, q url.Values) (any, error) {
+	kind, err := optionalInt(q, "k", 1)
+	if err != nil {
+		return nil, err
+	}
+	var match func(c Claim) bool
+	switch kind {
+	case 1:
+		match = func(c Claim) bool { return c.Status == ClaimComplete }
+	case 2:
+		match = func(c Claim) bool { return c.Status == ClaimDropped }
+	case 3:
+		match = func(c Claim) bool { return c.Status == ClaimActive && !c.Expires.After(s.Now()) }
+	default:
+		return nil, invalid("k", "The selected k is invalid.")
+	}
+	claims := []models.GetClaims{}
+	for _, c := range s.data.Claims {
+		if match(c) {
+			claims = append(claims, s.claim(c))
+		}
+	}
+	if len(claims) > 1000 {
+		claims = claims[:1000]
+	}
+	return claims, nil
+}
+
+func (s *Server) getTopTenUsers(caller *User, q url.Values) (any, error) {
+	users := s.data.rankedUsers()
+	if len(users) > 10 {
+		users = users[:10]
+	}
+	top := []models.GetTopTenUsers{}
+	for _, u := range users {
+		points, _, retro := s.data.points(u.Username)
+		top = append(top, models.GetTopTenUsers{
+			Username:      u.Username,
+			HarcordPoints: points,
+			RetroPoints:   retro,
+		})
+	}
+	return top, nil
+}
@@ -0,0 +1,363 @@
+package fake
+
+import (
+	"cmp"
+	"crypto/md5"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// unofficial reads the f query parameter, 5 asks for unofficial achievements instead of official ones
+func unofficial(q url.Values) bool {
+	return q.Get("f") == "5"
+}
+
+func (s *Server) getGame(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	console := s.data.console(game.ConsoleID)
+	return models.GetGame{
+		Title:        game.Title,
+		ConsoleID:    game.ConsoleID,
+		ForumTopicID: intOrNil(game.ForumTopicID),
+		ImageIcon:    gameImage(game.ID, 0),
+		ImageTitle:   gameImage(game.ID, 1),
+		ImageIngame:  gameImage(game.ID, 2),
+		ImageBoxArt:  gameImage(game.ID, 3),
+		Publisher:    game.Publisher,
+		Developer:    game.Developer,
+		Genre:        game.Genre,
+		Released:     dateOrNil(game.Released),
+		GameTitle:    game.Title,
+		ConsoleName:  console.Name,
+		Console:      console.Name,
+		GameIcon:     gameImage(game.ID, 0),
+	}, nil
+}
+
+// numAwarded counts the users who unlocked an achievement, and those who did in hardcore
+func (d *Dataset) numAwarded(achievementID int) (all int, hardcore int) {
+	for _, u := range d.Unlocks {
+		if u.AchievementID != achievementID {
+			continue
+		}
+		all++
+		if u.Hardcore {
+			hardcore++
+		}
+	}
+	return all, hardcore
+}
+
+func (s *Server) getGameExtended(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	achievements := map[int]models.GetGameExtentedAchievement{}
+	for _, a := range s.data.gameAchievements(game.ID, unofficial(q)) {
+		awarded, awardedHardcore := s.data.numAwarded(a.ID)
+		achievements[a.ID] = models.GetGameExtentedAchievement{
+			Title:              a.Title,
+			Description:        a.Description,
+			Points:             a.Points,
+			TrueRatio:          trueRatio(a),
+			Author:             a.Author,
+			ID:                 a.ID,
+			NumAwarded:         awarded,
+			NumAwardedHardcore: awardedHardcore,
+			DateModified:       models.DateTime{Time: a.DateModified.UTC()},
+			DateCreated:        models.DateTime{Time: a.DateCreated.UTC()},
+			BadgeName:          a.BadgeName,
+			DisplayOrder:       a.DisplayOrder,
+			MemAddr:            a.MemAddr,
+			Type:               a.Type,
+		}
+	}
+	claims := []models.GetGameExtentedClaim{}
+	for _, c := range s.data.Claims {
+		if c.GameID == game.ID && s.activeClaim(c) {
+			claims = append(claims, models.GetGameExtentedClaim{
+				User:       c.Username,
+				SetType:    c.SetType,
+				GameID:     c.GameID,
+				ClaimType:  c.ClaimType,
+				Created:    models.DateTime{Time: c.Created.UTC()},
+				Expiration: models.DateTime{Time: c.Expires.UTC()},
+			})
+		}
+	}
+	players, hardcore := s.data.players(game.ID)
+	var updated *time.Time
+	if !game.Updated.IsZero() {
+		u := game.Updated.UTC()
+		updated = &u
+	}
+	return models.GetGameExtented{
+		Title:                      game.Title,
+		ConsoleID:                  game.ConsoleID,
+		ForumTopicID:               intOrNil(game.ForumTopicID),
+		ImageIcon:                  gameImage(game.ID, 0),
+		ImageTitle:                 gameImage(game.ID, 1),
+		ImageIngame:                gameImage(game.ID, 2),
+		ImageBoxArt:                gameImage(game.ID, 3),
+		Publisher:                  game.Publisher,
+		Developer:                  game.Developer,
+		Genre:                      game.Genre,
+		Released:                   dateOrNil(game.Released),
+		ID:                         game.ID,
+		RichPresencePatch:          richPresenceHash(game.RichPresencePatch),
+		GuideURL:                   stringOrNil(game.GuideURL),
+		Updated:                    updated,
+		ConsoleName:                s.data.console(game.ConsoleID).Name,
+		ParentGameID:               intOrNil(game.ParentGameID),
+		NumDistinctPlayers:         len(players),
+		NumAchievements:            len(achievements),
+		Achievements:               achievements,
+		Claims:                     claims,
+		NumDistinctPlayersCasual:   len(players),
+		NumDistinctPlayersHardcore: len(hardcore),
+	}, nil
+}
+
+// richPresenceHash is the MD5 hash of the rich presence script, as sent by the API
+func richPresenceHash(patch string) string {
+	if patch == "" {
+		return ""
+	}
+	sum := md5.Sum([]byte(patch))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Server) getGameHashes(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetGameHashesResult{}
+	for _, h := range s.data.Hashes {
+		if h.GameID != game.ID {
+			continue
+		}
+		labels := h.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		results = append(results, models.GetGameHashesResult{
+			Name:     h.Name,
+			MD5:      h.MD5,
+			Labels:   labels,
+			PatchUrl: stringOrNil(h.PatchURL),
+		})
+	}
+	return models.GetGameHashes{
+		Results: results,
+	}, nil
+}
+
+func (s *Server) getAchievementCount(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	ids := []int{}
+	for _, a := range s.data.gameAchievements(game.ID, false) {
+		ids = append(ids, a.ID)
+	}
+	slices.Sort(ids)
+	return models.GetAchievementCount{
+		GameID:         game.ID,
+		AchievementIDs: ids,
+	}, nil
+}
+
+func (s *Server) getAchievementDistribution(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	hardcoreOnly := flag(q, "h")
+	achievements := s.data.gameAchievements(game.ID, unofficial(q))
+	distribution := models.GetAchievementDistribution{}
+	for i := range achievements {
+		distribution[strconv.Itoa(i+1)] = 0
+	}
+	players, _ := s.data.players(game.ID)
+	for _, username := range players {
+		unlocked := s.data.unlocked(username)
+		count := 0
+		for _, a := range achievements {
+			if u, ok := unlocked[a.ID]; ok && (u.Hardcore || !hardcoreOnly) {
+				count++
+			}
+		}
+		if count > 0 {
+			distribution[strconv.Itoa(count)]++
+		}
+	}
+	return distribution, nil
+}
+
+func (s *Server) getGameRankAndScore(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "g")
+	if err != nil {
+		return nil, err
+	}
+	latestMasters := q.Get("t") == "1"
+	type entry struct {
+		models.GetGameRankAndScore
+		last time.Time
+	}
+	entries := []entry{}
+	players, _ := s.data.players(game.ID)
+	for _, username := range players {
+		u := s.data.user(username)
+		if u == nil || u.Untracked {
+			continue
+		}
+		p := s.data.progress(u.Username, game.ID)
+		last := p.last
+		if latestMasters {
+			highest := s.data.highestAward(u.Username, game.ID)
+			if highest == nil || highest.kind != AwardMastered {
+				continue
+			}
+			last = highest.date
+		}
+		entries = append(entries, entry{
+			GetGameRankAndScore: models.GetGameRankAndScore{
+				User:            u.Username,
+				NumAchievements: p.achievedHardcore,
+				TotalScore:      p.scoreHardcore,
+				LastAward:       models.DateTime{Time: last.UTC()},
+			},
+			last: last,
+		})
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		if latestMasters {
+			return b.last.Compare(a.last)
+		}
+		return cmp.Or(cmp.Compare(b.TotalScore, a.TotalScore), a.last.Compare(b.last), strings.Compare(a.User, b.User))
+	})
+	results := []models.GetGameRankAndScore{}
+	for _, e := range entries {
+		if e.TotalScore == 0 && !latestMasters {
+			continue
+		}
+		results = append(results, e.GetGameRankAndScore)
+		if len(results) == 10 {
+			break
+		}
+	}
+	return results, nil
+}
+
+// median returns the middle of a list of seconds, nil when the list is empty
+func median(seconds []int) *int {
+	if len(seconds) == 0 {
+		return nil
+	}
+	sorted := slices.Sorted(slices.Values(seconds))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		m := (sorted[mid-1] + sorted[mid]) / 2
+		return &m
+	}
+	return &sorted[mid]
+}
+
+// gameStart returns when a user unlocked their first official achievement of a game, which stands in for when they started playing it
+func (d *Dataset) gameStart(username string, gameID int) time.Time {
+	unlocked := d.unlocked(username)
+	var start time.Time
+	for _, a := range d.gameAchievements(gameID, false) {
+		if u, ok := unlocked[a.ID]; ok && (start.IsZero() || u.Date.Before(start)) {
+			start = u.Date
+		}
+	}
+	return start
+}
+
+func (s *Server) getGameProgression(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	all, hardcore := s.data.players(game.ID)
+	players := all
+	if flag(q, "h") {
+		players = hardcore
+	}
+	var beat, beatHardcore, complete, master []int
+	unlockTimes := map[int][]int{}
+	unlockTimesHardcore := map[int][]int{}
+	for _, username := range players {
+		start := s.data.gameStart(username, game.ID)
+		for _, a := range s.data.gameAwards(username, game.ID) {
+			seconds := int(a.date.Sub(start).Seconds())
+			switch a.kind {
+			case AwardBeatenSoftcore:
+				beat = append(beat, seconds)
+			case AwardBeatenHardcore:
+				beatHardcore = append(beatHardcore, seconds)
+			case AwardCompleted:
+				complete = append(complete, seconds)
+			case AwardMastered:
+				master = append(master, seconds)
+			}
+		}
+		for id, u := range s.data.unlocked(username) {
+			seconds := int(u.Date.Sub(start).Seconds())
+			unlockTimes[id] = append(unlockTimes[id], seconds)
+			if u.Hardcore {
+				unlockTimesHardcore[id] = append(unlockTimesHardcore[id], seconds)
+			}
+		}
+	}
+	achievements := []models.GetGameProgressionAchievement{}
+	for _, a := range s.data.gameAchievements(game.ID, false) {
+		awarded, awardedHardcore := s.data.numAwarded(a.ID)
+		achievements = append(achievements, models.GetGameProgressionAchievement{
+			ID:                              a.ID,
+			Title:                           a.Title,
+			Description:                     a.Description,
+			Points:                          a.Points,
+			TrueRatio:                       trueRatio(a),
+			Type:                            stringOrNil(a.Type),
+			BadgeName:                       a.BadgeName,
+			NumAwarded:                      awarded,
+			NumAwardedHardcore:              awardedHardcore,
+			TimesUsedInUnlockMedian:         len(unlockTimes[a.ID]),
+			TimesUsedInHardcoreUnlockMedian: len(unlockTimesHardcore[a.ID]),
+			MedianTimeToUnlock:              median(unlockTimes[a.ID]),
+			MedianTimeToUnlockHardcore:      median(unlockTimesHardcore[a.ID]),
+		})
+	}
+	return models.GetGameProgression{
+		ID:                            game.ID,
+		Title:                         game.Title,
+		ConsoleID:                     game.ConsoleID,
+		ConsoleName:                   s.data.console(game.ConsoleID).Name,
+		ImageIcon:                     gameImage(game.ID, 0),
+		NumDistinctPlayers:            len(players),
+		TimesUsedInBeatMedian:         len(beat),
+		TimesUsedInHardcoreBeatMedian: len(beatHardcore),
+		MedianTimeToBeat:              median(beat),
+		MedianTimeToBeatHardcore:      median(beatHardcore),
+		TimesUsedInCompletionMedian:   len(complete),
+		TimesUsedInMasteryMedian:      len(master),
+		MedianTimeToComplete:          median(complete),
+		MedianTimeToMaster:            median(master),
+		NumAchievements:               len(achievements),
+		Achievements:                  achievements,
+	}, nil
+}
@@ -0,0 +1,273 @@
+package fake
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// GeneratedAt is the time generated datasets are built around, set Server.Now to it so
+// active claims and recent unlocks of a generated dataset look current
+var GeneratedAt = time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+var (
+	generatedConsoles = []Console{
+		{ID: 1, Name: "Genesis/Mega Drive", Active: true, IsGameSystem: true},
+		{ID: 2, Name: "Nintendo 64", Active: true, IsGameSystem: true},
+		{ID: 3, Name: "SNES/Super Famicom", Active: true, IsGameSystem: true},
+		{ID: 4, Name: "Game Boy", Active: true, IsGameSystem: true},
+		{ID: 5, Name: "Game Boy Advance", Active: true, IsGameSystem: true},
+		{ID: 7, Name: "NES/Famicom", Active: true, IsGameSystem: true},
+		{ID: 72, Name: "WASM-4", Active: false, IsGameSystem: true},
+		{ID: 100, Name: "Hubs", Active: true, IsGameSystem: false},
+		{ID: 101, Name: "Events", Active: true, IsGameSystem: false},
+	}
+	generatedUsernames = []string{
+		"PixelPilot", "RetroRaven", "ChipTune", "SpeedySnail", "BitBard",
+		"LoopLord", "CartCollector", "GlitchHunter", "SaveState", "HighScorer",
+	}
+	generatedTitleWords = [][]string{
+		{"Super", "Mega", "Turbo", "Hyper", "Crystal", "Shadow", "Pocket", "Final"},
+		{"Knight", "Racer", "Quest", "Fighter", "Island", "Dungeon", "Galaxy", "Puzzle"},
+		{"Adventure", "Legends", "Chronicles", "Deluxe", "Returns", "Saga", "Mania", "Heroes"},
+	}
+	generatedCompanies = []string{"Pixel Works", "Bitwise Games", "Cartridge Co.", "Sprite Studio", "Raster Soft"}
+	generatedGenres    = []string{"Platformer", "Racing", "Role-Playing Game", "Fighting", "Puzzle", "Shoot'em Up"}
+	generatedFormats   = []string{"SCORE", "TIME", "VALUE", "MILLISECS", "SECS"}
+	generatedPoints    = []int{1, 2, 3, 4, 5, 5, 10, 10, 25, 50}
+	generatedComments  = []string{
+		"Great set, thanks for making it!",
+		"This one is harder than it looks.",
+		"Took me a few tries but got it.",
+		"Anyone know how to trigger this?",
+		"Fun game, recommended.",
+	}
+)
+
+// GenerateDataset builds a consistent dataset of users, games, achievements, unlocks, leaderboards, tickets,
+// claims and comments. The same seed always gives the same dataset. Every generated user has an API key.
+func GenerateDataset(seed uint64) Dataset {
+	rng := rand.New(rand.NewPCG(seed, seed^0x5eed))
+	pick := func(list []string) string {
+		return list[rng.IntN(len(list))]
+	}
+	daysBefore := func(days int) time.Time {
+		return GeneratedAt.Add(-time.Duration(rng.IntN(days*24*60)+1) * time.Minute)
+	}
+	d := Dataset{
+		Consoles: generatedConsoles,
+	}
+	gameConsoles := []Console{}
+	for _, c := range d.Consoles {
+		if c.Active && c.IsGameSystem {
+			gameConsoles = append(gameConsoles, c)
+		}
+	}
+
+	for i, name := range generatedUsernames {
+		permissions := 0
+		switch {
+		case i < 3:
+			permissions = 2
+		case i == 3:
+			permissions = 1
+		}
+		d.Users = append(d.Users, User{
+			ID:              i + 1,
+			Username:        name,
+			MemberSince:     daysBefore(3000),
+			Motto:           fmt.Sprintf("%s was here", name),
+			RichPresenceMsg: "Playing through the first level",
+			Permissions:     permissions,
+			APIKey:          fmt.Sprintf("%016x%016x", rng.Uint64(), rng.Uint64()),
+		})
+	}
+	developers := d.Users[:4]
+
+	const numGames, gamesWithoutSets = 12, 2
+	for i := range numGames {
+		id := i + 1
+		title := fmt.Sprintf("%s %s %s", pick(generatedTitleWords[0]), pick(generatedTitleWords[1]), pick(generatedTitleWords[2]))
+		d.Games = append(d.Games, Game{
+			ID:                id,
+			Title:             title,
+			ConsoleID:         gameConsoles[rng.IntN(len(gameConsoles))].ID,
+			ForumTopicID:      1000 + id,
+			Publisher:         pick(generatedCompanies),
+			Developer:         pick(generatedCompanies),
+			Genre:             pick(generatedGenres),
+			Released:          time.Date(1985+rng.IntN(20), time.Month(1+rng.IntN(12)), 1+rng.IntN(28), 0, 0, 0, 0, time.UTC),
+			RichPresencePatch: fmt.Sprintf("Display:\nPlaying %s", title),
+			Updated:           daysBefore(365),
+		})
+		for h := range 1 + rng.IntN(2) {
+			d.Hashes = append(d.Hashes, Hash{
+				GameID: id,
+				Name:   fmt.Sprintf("%s (Rev %d)", title, h),
+				MD5:    fmt.Sprintf("%016x%016x", rng.Uint64(), rng.Uint64()),
+				Labels: []string{"nointro"},
+			})
+		}
+		if i >= numGames-gamesWithoutSets {
+			continue
+		}
+		count := 6 + rng.IntN(10)
+		for a := range count {
+			kind := ""
+			switch {
+			case a < 2:
+				kind = "progression"
+			case a == count-1:
+				kind = "win_condition"
+			case rng.IntN(5) == 0:
+				kind = "missable"
+			}
+			created := daysBefore(2000)
+			d.Achievements = append(d.Achievements, Achievement{
+				ID:           len(d.Achievements) + 1,
+				GameID:       id,
+				Title:        fmt.Sprintf("%s %d", pick(generatedTitleWords[1]), a+1),
+				Description:  fmt.Sprintf("Complete challenge %d", a+1),
+				Points:       generatedPoints[rng.IntN(len(generatedPoints))],
+				Author:       developers[rng.IntN(len(developers))].Username,
+				BadgeName:    fmt.Sprintf("%05d", 10000+len(d.Achievements)),
+				Type:         kind,
+				DisplayOrder: a,
+				MemAddr:      fmt.Sprintf("0xH%04x=%d", rng.IntN(0x10000), rng.IntN(10)),
+				DateCreated:  created,
+				DateModified: created.Add(time.Duration(rng.IntN(1000)) * time.Hour),
+			})
+		}
+		if i == 0 {
+			for a := range 2 {
+				d.Achievements = append(d.Achievements, Achievement{
+					ID:           len(d.Achievements) + 1,
+					GameID:       id,
+					Title:        fmt.Sprintf("Work In Progress %d", a+1),
+					Description:  "Not ready yet",
+					Points:       5,
+					Author:       developers[0].Username,
+					BadgeName:    fmt.Sprintf("%05d", 10000+len(d.Achievements)),
+					DisplayOrder: count + a,
+					Unofficial:   true,
+					DateCreated:  daysBefore(30),
+					DateModified: daysBefore(30),
+				})
+			}
+		}
+		for l := range rng.IntN(4) {
+			d.Leaderboards = append(d.Leaderboards, Leaderboard{
+				ID:          len(d.Leaderboards) + 1,
+				GameID:      id,
+				Title:       fmt.Sprintf("%s %d", pick(generatedTitleWords[2]), l+1),
+				Description: "Best result",
+				Format:      pick(generatedFormats),
+				RankAsc:     rng.IntN(2) == 0,
+			})
+		}
+	}
+
+	for _, u := range d.Users {
+		skill := 0.3 + rng.Float64()*0.7
+		hardcore := rng.IntN(10) < 7
+		for _, g := range rng.Perm(numGames - gamesWithoutSets)[:2+rng.IntN(5)] {
+			gameID := g + 1
+			for _, a := range d.gameAchievements(gameID, false) {
+				if rng.Float64() > skill {
+					continue
+				}
+				d.Unlocks = append(d.Unlocks, Unlock{
+					Username:      u.Username,
+					AchievementID: a.ID,
+					Hardcore:      hardcore,
+					Date:          daysBefore(365),
+				})
+			}
+			for _, lb := range d.gameLeaderboards(gameID) {
+				d.LeaderboardEntries = append(d.LeaderboardEntries, LeaderboardEntry{
+					LeaderboardID: lb.ID,
+					Username:      u.Username,
+					Score:         100 + rng.IntN(100000),
+					Date:          daysBefore(365),
+				})
+			}
+		}
+	}
+
+	for i := range 12 {
+		a := d.Achievements[rng.IntN(len(d.Achievements))]
+		state := []int{TicketOpen, TicketOpen, TicketOpen, TicketRequest, TicketResolved, TicketClosed}[rng.IntN(6)]
+		ticket := Ticket{
+			ID:            i + 1,
+			AchievementID: a.ID,
+			ReportedBy:    d.Users[rng.IntN(len(d.Users))].Username,
+			ReportType:    1 + rng.IntN(2),
+			State:         state,
+			Hardcore:      rng.IntN(2) == 0,
+			Notes:         "Achievement did not behave as described",
+			ReportedAt:    daysBefore(180),
+		}
+		if state == TicketResolved || state == TicketClosed {
+			ticket.ResolvedAt = ticket.ReportedAt.Add(time.Duration(1+rng.IntN(240)) * time.Hour)
+			ticket.ResolvedBy = a.Author
+		}
+		d.Tickets = append(d.Tickets, ticket)
+	}
+
+	for i, status := range []int{ClaimActive, ClaimActive, ClaimComplete, ClaimComplete, ClaimDropped} {
+		gameID := numGames - gamesWithoutSets + 1 + i%gamesWithoutSets
+		setType := 0
+		if status != ClaimActive {
+			gameID = 1 + rng.IntN(numGames-gamesWithoutSets)
+			setType = 1
+		}
+		created := daysBefore(60)
+		d.Claims = append(d.Claims, Claim{
+			ID:        i + 1,
+			Username:  developers[i%len(developers)].Username,
+			GameID:    gameID,
+			ClaimType: i % 2,
+			SetType:   setType,
+			Status:    status,
+			Created:   created,
+			Expires:   created.AddDate(0, 3, 0),
+			Updated:   created.Add(time.Duration(rng.IntN(24*30)) * time.Hour),
+		})
+	}
+
+	for range 15 {
+		c := Comment{
+			Username:  d.Users[rng.IntN(len(d.Users))].Username,
+			Text:      pick(generatedComments),
+			Submitted: daysBefore(365),
+		}
+		switch rng.IntN(3) {
+		case 0:
+			c.GameID = d.Games[rng.IntN(len(d.Games))].ID
+		case 1:
+			c.AchievementID = d.Achievements[rng.IntN(len(d.Achievements))].ID
+		default:
+			c.Profile = d.Users[rng.IntN(len(d.Users))].Username
+		}
+		d.Comments = append(d.Comments, c)
+	}
+
+	for i := range d.Users {
+		for _, other := range rng.Perm(len(d.Users))[:rng.IntN(5)] {
+			if other != i {
+				d.Users[i].Following = append(d.Users[i].Following, d.Users[other].Username)
+			}
+		}
+		for _, g := range rng.Perm(numGames)[:rng.IntN(4)] {
+			d.Users[i].WantToPlay = append(d.Users[i].WantToPlay, g+1)
+		}
+		for g := numGames - gamesWithoutSets; g < numGames; g++ {
+			if rng.IntN(2) == 0 {
+				d.Users[i].SetRequests = append(d.Users[i].SetRequests, g+1)
+			}
+		}
+	}
+
+	d.AchievementOfTheWeek = d.Achievements[rng.IntN(len(d.Achievements))].ID
+	return d
+}
@@ -0,0 +1,141 @@
+package fake
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// formatScore renders a score the way the site displays it for the leaderboard format
+func formatScore(format string, score int) string {
+	switch format {
+	case "TIME":
+		// frames at 60 frames per second
+		centiseconds := score * 100 / 60
+		return fmt.Sprintf("%d:%02d.%02d", centiseconds/6000, centiseconds/100%60, centiseconds%100)
+	case "MILLISECS":
+		// hundredths of a second
+		return fmt.Sprintf("%d:%02d.%02d", score/6000, score/100%60, score%100)
+	case "SECS":
+		return fmt.Sprintf("%d:%02d", score/60, score%60)
+	case "SCORE":
+		return fmt.Sprintf("%06d", score)
+	}
+	return strconv.Itoa(score)
+}
+
+func (s *Server) getGameLeaderboards(caller *User, q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	leaderboards := s.data.gameLeaderboards(game.ID)
+	page, err := paginate(q, leaderboards, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetGameLeaderboardsResult{}
+	for _, lb := range page {
+		var top *models.GetGameLeaderboardsTopEntry
+		if entries := s.data.rankedEntries(lb.ID); len(entries) > 0 {
+			top = &models.GetGameLeaderboardsTopEntry{
+				User:           entries[0].Username,
+				Score:          entries[0].Score,
+				FormattedScore: formatScore(lb.Format, entries[0].Score),
+			}
+		}
+		results = append(results, models.GetGameLeaderboardsResult{
+			ID:          lb.ID,
+			RankAsc:     lb.RankAsc,
+			Title:       lb.Title,
+			Description: lb.Description,
+			Format:      lb.Format,
+			TopEntry:    top,
+		})
+	}
+	return models.GetGameLeaderboards{
+		Count:   len(results),
+		Total:   len(leaderboards),
+		Results: results,
+	}, nil
+}
+
+func (s *Server) getLeaderboardEntries(caller *User, q url.Values) (any, error) {
+	id, err := requiredInt(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	lb := s.data.leaderboard(id)
+	if lb == nil {
+		return nil, notFound()
+	}
+	entries := s.data.rankedEntries(lb.ID)
+	offset, err := optionalInt(q, "o", 0)
+	if err != nil {
+		return nil, err
+	}
+	page, err := paginate(q, entries, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetLeaderboardEntriesResult{}
+	for i, e := range page {
+		results = append(results, models.GetLeaderboardEntriesResult{
+			Rank:           offset + i + 1,
+			User:           e.Username,
+			Score:          e.Score,
+			FormattedScore: formatScore(lb.Format, e.Score),
+			DateSubmitted:  models.RFC3339NumColonTZ{Time: e.Date.UTC()},
+		})
+	}
+	return models.GetLeaderboardEntries{
+		Count:   len(results),
+		Total:   len(entries),
+		Results: results,
+	}, nil
+}
+
+func (s *Server) getUserGameLeaderboards(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	game, err := s.requiredGame(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	all := []models.GetUserGameLeaderboardsResult{}
+	for _, lb := range s.data.gameLeaderboards(game.ID) {
+		for rank, e := range s.data.rankedEntries(lb.ID) {
+			if !strings.EqualFold(e.Username, user.Username) {
+				continue
+			}
+			all = append(all, models.GetUserGameLeaderboardsResult{
+				ID:          lb.ID,
+				RankAsc:     lb.RankAsc,
+				Title:       lb.Title,
+				Description: lb.Description,
+				Format:      lb.Format,
+				UserEntry: models.GetUserGameLeaderboardsUserEntry{
+					Rank:           rank + 1,
+					User:           user.Username,
+					Score:          e.Score,
+					FormattedScore: formatScore(lb.Format, e.Score),
+					DateUpdated:    models.RFC3339NumColonTZ{Time: e.Date.UTC()},
+				},
+			})
+		}
+	}
+	page, err := paginate(q, all, 200, 500)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetUserGameLeaderboards{
+		Count:   len(page),
+		Total:   len(all),
+		Results: page,
+	}, nil
+}
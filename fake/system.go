@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getConsoleIDs(caller *User, q url.Values) (any, error) {
+	onlyActive, onlyGameSystems := flag(q, "a"), flag(q, "g")
+	consoles := []models.GetConsoleIDs{}
+	for _, c := range s.data.Consoles {
+		if (onlyActive && !c.Active) || (onlyGameSystems && !c.IsGameSystem) {
+			continue
+		}
+		consoles = append(consoles, models.GetConsoleIDs{
+			ID:           c.ID,
+			Name:         c.Name,
+			IconURL:      fmt.Sprintf("%s/assets/images/system/%s.png", staticURL, strings.ToLower(strings.ReplaceAll(c.Name, " ", ""))),
+			Active:       c.Active,
+			IsGameSystem: c.IsGameSystem,
+		})
+	}
+	return consoles, nil
+}
+
+func (s *Server) getGameList(caller *User, q url.Values) (any, error) {
+	consoleID, err := requiredInt(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	onlyWithAchievements, withHashes := flag(q, "f"), flag(q, "h")
+	games := []models.GetGameList{}
+	for _, g := range s.data.Games {
+		if g.ConsoleID != consoleID {
+			continue
+		}
+		achievements := s.data.gameAchievements(g.ID, false)
+		if onlyWithAchievements && len(achievements) == 0 {
+			continue
+		}
+		points := 0
+		var modified *models.DateTime
+		for _, a := range achievements {
+			points += a.Points
+			if modified == nil || a.DateModified.After(modified.Time) {
+				modified = &models.DateTime{Time: a.DateModified.UTC()}
+			}
+		}
+		var hashes []string
+		if withHashes {
+			hashes = []string{}
+			for _, h := range s.data.Hashes {
+				if h.GameID == g.ID {
+					hashes = append(hashes, h.MD5)
+				}
+			}
+		}
+		games = append(games, models.GetGameList{
+			Title:           g.Title,
+			ID:              g.ID,
+			ConsoleID:       g.ConsoleID,
+			ConsoleName:     s.data.console(g.ConsoleID).Name,
+			ImageIcon:       gameImage(g.ID, 0),
+			NumAchievements: len(achievements),
+			NumLeaderboards: len(s.data.gameLeaderboards(g.ID)),
+			Points:          points,
+			DateModified:    modified,
+			ForumTopicID:    intOrNil(g.ForumTopicID),
+			Hashes:          hashes,
+		})
+	}
+	return paginate(q, games, len(games), 0)
+}
@@ -0,0 +1,256 @@
+package fake
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+var ticketStateDescriptions = map[int]string{
+	TicketClosed:   "Closed",
+	TicketOpen:     "Open",
+	TicketResolved: "Resolved",
+	TicketRequest:  "Request",
+}
+
+var ticketTypeDescriptions = map[int]string{
+	1: "Triggered at the wrong time",
+	2: "Did not trigger",
+}
+
+// openTicket reports whether a ticket still waits for the developer or the reporter
+func openTicket(t Ticket) bool {
+	return t.State == TicketOpen || t.State == TicketRequest
+}
+
+// ticket converts a ticket to the API answer, every ticket listing answers with the same fields
+func (s *Server) ticket(t Ticket) models.GetMostRecentTicketsRecentTicket {
+	a := s.data.achievement(t.AchievementID)
+	if a == nil {
+		a = &Achievement{ID: t.AchievementID}
+	}
+	game := s.data.gameInfo(a.GameID)
+	var resolvedAt *models.DateTime
+	if !t.ResolvedAt.IsZero() {
+		resolvedAt = &models.DateTime{Time: t.ResolvedAt.UTC()}
+	}
+	hardcore := boolInt(t.Hardcore)
+	return models.GetMostRecentTicketsRecentTicket{
+		ID:                     t.ID,
+		AchievementID:          a.ID,
+		AchievementTitle:       a.Title,
+		AchievementDesc:        a.Description,
+		AchievementType:        stringOrNil(a.Type),
+		Points:                 a.Points,
+		BadgeName:              a.BadgeName,
+		AchievementAuthor:      a.Author,
+		GameID:                 game.ID,
+		ConsoleName:            s.data.console(game.ConsoleID).Name,
+		GameTitle:              game.Title,
+		GameIcon:               gameImage(game.ID, 0),
+		ReportedAt:             models.DateTime{Time: t.ReportedAt.UTC()},
+		ReportType:             t.ReportType,
+		ReportState:            t.State,
+		Hardcore:               &hardcore,
+		ReportNotes:            t.Notes,
+		ReportedBy:             t.ReportedBy,
+		ResolvedAt:             resolvedAt,
+		ResolvedBy:             stringOrNil(t.ResolvedBy),
+		ReportStateDescription: ticketStateDescriptions[t.State],
+		ReportTypeDescription:  ticketTypeDescriptions[t.ReportType],
+	}
+}
+
+// tickets returns the tickets matching a filter, most recent first
+func (s *Server) tickets(match func(t Ticket, a *Achievement) bool) []Ticket {
+	tickets := []Ticket{}
+	for _, t := range s.data.Tickets {
+		a := s.data.achievement(t.AchievementID)
+		if a != nil && match(t, a) {
+			tickets = append(tickets, t)
+		}
+	}
+	slices.SortStableFunc(tickets, func(a, b Ticket) int {
+		return cmp.Or(b.ReportedAt.Compare(a.ReportedAt), cmp.Compare(b.ID, a.ID))
+	})
+	return tickets
+}
+
+// getTicketData answers the several reports sharing the ticket endpoint, picked by the query parameters
+func (s *Server) getTicketData(caller *User, q url.Values) (any, error) {
+	switch {
+	case q.Has("i"):
+		return s.getTicketByID(q)
+	case flag(q, "f"):
+		return s.getMostTicketedGames(q)
+	case q.Has("u"):
+		return s.getDeveloperTicketStats(q)
+	case q.Has("g"):
+		return s.getGameTicketStats(q)
+	case q.Has("a"):
+		return s.getAchievementTicketStats(q)
+	}
+	return s.getMostRecentTickets(q)
+}
+
+func (s *Server) getTicketByID(q url.Values) (any, error) {
+	id, err := requiredInt(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range s.data.Tickets {
+		if t.ID != id {
+			continue
+		}
+		r := s.ticket(t)
+		return models.GetTicketByID{
+			ID:                     r.ID,
+			AchievementID:          r.AchievementID,
+			AchievementTitle:       r.AchievementTitle,
+			AchievementDesc:        r.AchievementDesc,
+			AchievementType:        r.AchievementType,
+			Points:                 r.Points,
+			BadgeName:              r.BadgeName,
+			AchievementAuthor:      r.AchievementAuthor,
+			GameID:                 r.GameID,
+			ConsoleName:            r.ConsoleName,
+			GameTitle:              r.GameTitle,
+			GameIcon:               r.GameIcon,
+			ReportedAt:             r.ReportedAt,
+			ReportType:             r.ReportType,
+			ReportState:            r.ReportState,
+			Hardcore:               r.Hardcore,
+			ReportNotes:            r.ReportNotes,
+			ReportedBy:             r.ReportedBy,
+			ResolvedAt:             r.ResolvedAt,
+			ResolvedBy:             r.ResolvedBy,
+			ReportStateDescription: r.ReportStateDescription,
+			ReportTypeDescription:  r.ReportTypeDescription,
+			URL:                    fmt.Sprintf("%s/ticket/%d", siteURL, t.ID),
+		}, nil
+	}
+	return nil, notFound()
+}
+
+func (s *Server) getMostTicketedGames(q url.Values) (any, error) {
+	counts := map[int]int{}
+	games := []int{}
+	for _, t := range s.tickets(func(t Ticket, a *Achievement) bool { return openTicket(t) && !a.Unofficial }) {
+		gameID := s.data.achievement(t.AchievementID).GameID
+		if counts[gameID] == 0 {
+			games = append(games, gameID)
+		}
+		counts[gameID]++
+	}
+	slices.SortStableFunc(games, func(a, b int) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+	page, err := paginate(q, games, 10, 100)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetMostTicketedGamesMostReportedGame{}
+	for _, id := range page {
+		game := s.data.gameInfo(id)
+		results = append(results, models.GetMostTicketedGamesMostReportedGame{
+			GameID:      game.ID,
+			GameTitle:   game.Title,
+			GameIcon:    gameImage(game.ID, 0),
+			Console:     s.data.console(game.ConsoleID).Name,
+			OpenTickets: counts[id],
+		})
+	}
+	return models.GetMostTicketedGames{
+		MostReportedGames: results,
+		URL:               siteURL + "/manage/most-reported-games",
+	}, nil
+}
+
+func (s *Server) getMostRecentTickets(q url.Values) (any, error) {
+	tickets := s.tickets(func(t Ticket, a *Achievement) bool { return openTicket(t) })
+	page, err := paginate(q, tickets, 10, 100)
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetMostRecentTicketsRecentTicket{}
+	for _, t := range page {
+		results = append(results, s.ticket(t))
+	}
+	return models.GetMostRecentTickets{
+		OpenTickets:   len(tickets),
+		URL:           siteURL + "/tickets",
+		RecentTickets: results,
+	}, nil
+}
+
+func (s *Server) getGameTicketStats(q url.Values) (any, error) {
+	game, err := s.requiredGame(q, "g")
+	if err != nil {
+		return nil, err
+	}
+	wantUnofficial := unofficial(q)
+	tickets := s.tickets(func(t Ticket, a *Achievement) bool {
+		return openTicket(t) && a.GameID == game.ID && a.Unofficial == wantUnofficial
+	})
+	results := []models.GetGameTicketStatsTicket{}
+	if flag(q, "d") {
+		for _, t := range tickets {
+			results = append(results, models.GetGameTicketStatsTicket(s.ticket(t)))
+		}
+	}
+	return models.GetGameTicketStats{
+		GameID:      game.ID,
+		GameTitle:   game.Title,
+		ConsoleName: s.data.console(game.ConsoleID).Name,
+		OpenTickets: len(tickets),
+		URL:         fmt.Sprintf("%s/game/%d/tickets", siteURL, game.ID),
+		Tickets:     results,
+	}, nil
+}
+
+func (s *Server) getDeveloperTicketStats(q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	stats := models.GetDeveloperTicketStats{
+		User: user.Username,
+		URL:  fmt.Sprintf("%s/user/%s/tickets", siteURL, user.Username),
+	}
+	for _, t := range s.tickets(func(t Ticket, a *Achievement) bool { return strings.EqualFold(a.Author, user.Username) }) {
+		switch {
+		case openTicket(t):
+			stats.Open++
+		case t.State == TicketResolved:
+			stats.Resolved++
+		default:
+			stats.Closed++
+		}
+		stats.Total++
+	}
+	return stats, nil
+}
+
+func (s *Server) getAchievementTicketStats(q url.Values) (any, error) {
+	id, err := requiredInt(q, "a")
+	if err != nil {
+		return nil, err
+	}
+	a := s.data.achievement(id)
+	if a == nil {
+		return nil, notFound()
+	}
+	tickets := s.tickets(func(t Ticket, ta *Achievement) bool { return openTicket(t) && ta.ID == a.ID })
+	return models.GetAchievementTicketStats{
+		AchievementID:          a.ID,
+		AchievementTitle:       a.Title,
+		AchievementDescription: a.Description,
+		AchievementType:        stringOrNil(a.Type),
+		URL:                    fmt.Sprintf("%s/achievement/%d/tickets", siteURL, a.ID),
+		OpenTickets:            len(tickets),
+	}, nil
+}
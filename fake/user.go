@@ -0,0 +1,715 @@
+package fake
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+func (s *Server) getUserProfile(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	points, softcore, retro := s.data.points(user.Username)
+	contribCount, contribYield := s.data.contributions(user.Username)
+	return models.GetUserProfile{
+		User:                user.Username,
+		UserPic:             userPic(user.Username),
+		MemberSince:         models.DateTime{Time: user.MemberSince.UTC()},
+		RichPresenceMsg:     user.RichPresenceMsg,
+		LastGameID:          s.lastGameID(user.Username),
+		ContribCount:        contribCount,
+		ContribYield:        contribYield,
+		TotalPoints:         points,
+		TotalSoftcorePoints: softcore,
+		TotalTruePoints:     retro,
+		Permissions:         user.Permissions,
+		Untracked:           boolInt(user.Untracked),
+		ID:                  user.ID,
+		UserWallActive:      true,
+		Motto:               user.Motto,
+	}, nil
+}
+
+// lastGameID returns the ID of the game a user last unlocked an achievement in, zero if none
+func (s *Server) lastGameID(username string) int {
+	if games := s.data.playedGames(username); len(games) > 0 {
+		return games[0]
+	}
+	return 0
+}
+
+// earned converts an unlock to the API answer, every listing of a user's unlocks answers with the same fields
+func (s *Server) earned(u Unlock) models.GetUserRecentAchievements {
+	a := s.data.achievement(u.AchievementID)
+	game := s.data.gameInfo(a.GameID)
+	return models.GetUserRecentAchievements{
+		Title:         a.Title,
+		Description:   a.Description,
+		Points:        a.Points,
+		TrueRatio:     trueRatio(*a),
+		Author:        a.Author,
+		Date:          models.DateTime{Time: u.Date.UTC()},
+		HardcoreMode:  boolInt(u.Hardcore),
+		AchievementID: a.ID,
+		BadgeName:     a.BadgeName,
+		Type:          a.Type,
+		GameTitle:     game.Title,
+		GameIcon:      gameImage(game.ID, 0),
+		GameID:        game.ID,
+		ConsoleName:   s.data.console(game.ConsoleID).Name,
+		BadgeURL:      badgeURL(a.BadgeName),
+		GameURL:       fmt.Sprintf("/game/%d", game.ID),
+	}
+}
+
+// unlocksBetween returns the unlocks of a user from the start time up to the end time excluded, oldest first
+func (s *Server) unlocksBetween(username string, start time.Time, end time.Time) []Unlock {
+	unlocks := []Unlock{}
+	for _, u := range s.data.userUnlocks(username) {
+		if !u.Date.Before(start) && u.Date.Before(end) && s.data.achievement(u.AchievementID) != nil {
+			unlocks = append(unlocks, u)
+		}
+	}
+	slices.Reverse(unlocks)
+	return unlocks
+}
+
+func (s *Server) getUserRecentAchievements(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	minutes, err := optionalInt(q, "m", 60)
+	if err != nil {
+		return nil, err
+	}
+	now := s.Now()
+	unlocks := s.unlocksBetween(user.Username, now.Add(-time.Duration(minutes)*time.Minute), now.Add(time.Nanosecond))
+	slices.Reverse(unlocks)
+	results := []models.GetUserRecentAchievements{}
+	for _, u := range unlocks {
+		results = append(results, s.earned(u))
+	}
+	return results, nil
+}
+
+func (s *Server) getAchievementsEarnedBetween(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	from, err := requiredInt(q, "f")
+	if err != nil {
+		return nil, err
+	}
+	to, err := requiredInt(q, "t")
+	if err != nil {
+		return nil, err
+	}
+	results := []models.GetAchievementsEarnedBetween{}
+	for _, u := range s.unlocksBetween(user.Username, time.Unix(int64(from), 0), time.Unix(int64(to)+1, 0)) {
+		results = append(results, models.GetAchievementsEarnedBetween(s.earned(u)))
+	}
+	return results, nil
+}
+
+func (s *Server) getAchievementsEarnedOnDay(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	d, err := required(q, "d")
+	if err != nil {
+		return nil, err
+	}
+	day, err := time.Parse(time.DateOnly, d)
+	if err != nil {
+		return nil, invalid("d", "The d field must match the format Y-m-d.")
+	}
+	results := []models.GetAchievementsEarnedOnDay{}
+	for _, u := range s.unlocksBetween(user.Username, day, day.AddDate(0, 0, 1)) {
+		results = append(results, models.GetAchievementsEarnedOnDay(s.earned(u)))
+	}
+	return results, nil
+}
+
+// percent renders a completion percentage the way the API does
+func percent(n int, total int) string {
+	if total == 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(n)*100/float64(total))
+}
+
+func (s *Server) getGameInfoAndUserProgress(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	game, err := s.requiredGame(q, "g")
+	if err != nil {
+		return nil, err
+	}
+	unlocked := s.data.unlocked(user.Username)
+	achievements := map[int]models.GetGameInfoAndUserProgressAchievement{}
+	for _, a := range s.data.gameAchievements(game.ID, false) {
+		awarded, awardedHardcore := s.data.numAwarded(a.ID)
+		achievement := models.GetGameInfoAndUserProgressAchievement{
+			ID:                 a.ID,
+			NumAwarded:         awarded,
+			NumAwardedHardcore: awardedHardcore,
+			Title:              a.Title,
+			Description:        a.Description,
+			Points:             a.Points,
+			TrueRatio:          trueRatio(a),
+			Author:             a.Author,
+			DateModified:       models.DateTime{Time: a.DateModified.UTC()},
+			DateCreated:        models.DateTime{Time: a.DateCreated.UTC()},
+			BadgeName:          a.BadgeName,
+			DisplayOrder:       a.DisplayOrder,
+			MemAddr:            a.MemAddr,
+			Type:               a.Type,
+		}
+		if u, ok := unlocked[a.ID]; ok {
+			achievement.DateEarned = &models.DateTime{Time: u.Date.UTC()}
+			if u.Hardcore {
+				achievement.DateEarnedHardcore = &models.DateTime{Time: u.Date.UTC()}
+			}
+		}
+		achievements[a.ID] = achievement
+	}
+	players, hardcore := s.data.players(game.ID)
+	p := s.data.progress(user.Username, game.ID)
+	var granularity *string
+	if !game.Released.IsZero() {
+		granularity = stringOrNil("day")
+	}
+	resp := models.GetGameInfoAndUserProgress{
+		ID:                         game.ID,
+		Title:                      game.Title,
+		SortTitle:                  strings.ToLower(game.Title),
+		ConsoleID:                  game.ConsoleID,
+		ForumTopicID:               intOrNil(game.ForumTopicID),
+		ImageIcon:                  gameImage(game.ID, 0),
+		ImageTitle:                 gameImage(game.ID, 1),
+		ImageIngame:                gameImage(game.ID, 2),
+		ImageBoxArt:                gameImage(game.ID, 3),
+		Publisher:                  game.Publisher,
+		Developer:                  game.Developer,
+		Genre:                      game.Genre,
+		Released:                   dateOrNil(game.Released),
+		ReleasedAtGranularity:      granularity,
+		RichPresencePatch:          richPresenceHash(game.RichPresencePatch),
+		GuideURL:                   stringOrNil(game.GuideURL),
+		ConsoleName:                s.data.console(game.ConsoleID).Name,
+		ParentGameID:               intOrNil(game.ParentGameID),
+		NumDistinctPlayers:         len(players),
+		NumAchievements:            len(achievements),
+		Achievements:               achievements,
+		NumDistinctPlayersCasual:   len(players),
+		NumDistinctPlayersHardcore: len(hardcore),
+		NumAwardedToUser:           p.achieved,
+		NumAwardedToUserHardcore:   p.achievedHardcore,
+		UserCompletion:             percent(p.achieved, p.possible),
+		UserCompletionHardcore:     percent(p.achievedHardcore, p.possible),
+	}
+	if flag(q, "a") {
+		if highest := s.data.highestAward(user.Username, game.ID); highest != nil {
+			resp.HighestAwardKind = &highest.kind
+			resp.HighestAwardDate = &models.RFC3339NumColonTZ{Time: highest.date.UTC()}
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) getUserCompletionProgress(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	all := []models.CompletionProgress{}
+	for _, id := range s.data.playedGames(user.Username) {
+		game := s.data.gameInfo(id)
+		p := s.data.progress(user.Username, id)
+		entry := models.CompletionProgress{
+			GameID:                game.ID,
+			Title:                 game.Title,
+			ImageIcon:             gameImage(game.ID, 0),
+			ConsoleID:             game.ConsoleID,
+			ConsoleName:           s.data.console(game.ConsoleID).Name,
+			MaxPossible:           p.possible,
+			NumAwarded:            p.achieved,
+			NumAwardedHardcore:    p.achievedHardcore,
+			MostRecentAwardedDate: models.RFC3339NumColonTZ{Time: p.last.UTC()},
+		}
+		if highest := s.data.highestAward(user.Username, id); highest != nil {
+			entry.HighestAwardKind = &highest.kind
+			entry.HighestAwardDate = &models.RFC3339NumColonTZ{Time: highest.date.UTC()}
+		}
+		all = append(all, entry)
+	}
+	page, err := paginate(q, all, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetUserCompletionProgress{
+		Count:   len(page),
+		Total:   len(all),
+		Results: page,
+	}, nil
+}
+
+func (s *Server) getUserAwards(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	resp := models.GetUserAwards{
+		VisibleUserAwards: []models.Award{},
+	}
+	for _, a := range s.data.awards(user.Username) {
+		game := s.data.gameInfo(a.gameID)
+		console := s.data.console(game.ConsoleID)
+		awardType, extra := "Game Beaten", 0
+		switch a.kind {
+		case AwardMastered:
+			awardType, extra = "Mastery/Completion", 1
+			resp.MasteryAwardsCount++
+		case AwardCompleted:
+			awardType = "Mastery/Completion"
+			resp.CompletionAwardsCount++
+		case AwardBeatenHardcore:
+			extra = 1
+			resp.BeatenHardcoreAwardsCount++
+		case AwardBeatenSoftcore:
+			resp.BeatenSoftcoreAwardsCount++
+		}
+		resp.TotalAwardsCount++
+		resp.VisibleUserAwards = append(resp.VisibleUserAwards, models.Award{
+			AwardedAt:      models.RFC3339NumColonTZ{Time: a.date.UTC()},
+			AwardType:      awardType,
+			AwardData:      game.ID,
+			AwardDataExtra: extra,
+			Title:          &game.Title,
+			ConsoleID:      &game.ConsoleID,
+			ConsoleName:    &console.Name,
+			ImageIcon:      stringOrNil(gameImage(game.ID, 0)),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) getUserClaims(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	claims := []models.GetUserClaims{}
+	for _, c := range s.data.Claims {
+		if strings.EqualFold(c.Username, user.Username) {
+			claims = append(claims, models.GetUserClaims(s.claim(c)))
+		}
+	}
+	return claims, nil
+}
+
+func (s *Server) getUserGameRankAndScore(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	game, err := s.requiredGame(q, "g")
+	if err != nil {
+		return nil, err
+	}
+	p := s.data.progress(user.Username, game.ID)
+	if p.achieved == 0 {
+		return []models.GetUserGameRankAndScore{}, nil
+	}
+	rank := 1
+	players, _ := s.data.players(game.ID)
+	for _, other := range players {
+		if u := s.data.user(other); u != nil && !u.Untracked && s.data.progress(other, game.ID).score > p.score {
+			rank++
+		}
+	}
+	return []models.GetUserGameRankAndScore{
+		{
+			User:       user.Username,
+			UserRank:   rank,
+			TotalScore: p.score,
+			LastAward:  &models.DateTime{Time: p.last.UTC()},
+		},
+	}, nil
+}
+
+func (s *Server) getUserPoints(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	points, softcore, _ := s.data.points(user.Username)
+	return models.GetUserPoints{
+		Points:         points,
+		SoftcorePoints: softcore,
+	}, nil
+}
+
+// userProgress converts a user's progress in a game to the API answer
+func userProgress(p progress) models.GetUserProgress {
+	return models.GetUserProgress{
+		NumPossibleAchievements: p.possible,
+		PossibleScore:           p.possibleScore,
+		NumAchieved:             p.achieved,
+		ScoreAchieved:           p.score,
+		NumAchievedHardcore:     p.achievedHardcore,
+		ScoreAchievedHardcore:   p.scoreHardcore,
+	}
+}
+
+func (s *Server) getUserProgress(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := required(q, "i")
+	if err != nil {
+		return nil, err
+	}
+	resp := map[string]models.GetUserProgress{}
+	for _, id := range strings.Split(ids, ",") {
+		gameID, err := strconv.Atoi(strings.TrimSpace(id))
+		if err != nil {
+			return nil, invalid("i", "The i field must be a list of integers.")
+		}
+		resp[strconv.Itoa(gameID)] = userProgress(s.data.progress(user.Username, gameID))
+	}
+	return resp, nil
+}
+
+// recentlyPlayed returns the games a user played, most recent first
+func (s *Server) recentlyPlayed(username string) []models.GetUserRecentlyPlayedGames {
+	games := []models.GetUserRecentlyPlayedGames{}
+	for _, id := range s.data.playedGames(username) {
+		game := s.data.gameInfo(id)
+		p := s.data.progress(username, id)
+		games = append(games, models.GetUserRecentlyPlayedGames{
+			NumPossibleAchievements: p.possible,
+			PossibleScore:           p.possibleScore,
+			NumAchieved:             p.achieved,
+			ScoreAchieved:           p.score,
+			NumAchievedHardcore:     p.achievedHardcore,
+			ScoreAchievedHardcore:   p.scoreHardcore,
+			GameID:                  game.ID,
+			ConsoleID:               game.ConsoleID,
+			ConsoleName:             s.data.console(game.ConsoleID).Name,
+			Title:                   game.Title,
+			ImageIcon:               gameImage(game.ID, 0),
+			ImageTitle:              gameImage(game.ID, 1),
+			ImageIngame:             gameImage(game.ID, 2),
+			ImageBoxArt:             gameImage(game.ID, 3),
+			LastPlayed:              models.DateTime{Time: p.last.UTC()},
+			AchievementsTotal:       p.possible,
+		})
+	}
+	return games
+}
+
+func (s *Server) getUserRecentlyPlayedGames(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	return paginate(q, s.recentlyPlayed(user.Username), 10, 50)
+}
+
+func (s *Server) getUserSummary(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	gamesCount, err := optionalInt(q, "g", 0)
+	if err != nil {
+		return nil, err
+	}
+	achievementsCount, err := optionalInt(q, "a", 10)
+	if err != nil {
+		return nil, err
+	}
+	points, softcore, retro := s.data.points(user.Username)
+	contribCount, contribYield := s.data.contributions(user.Username)
+	ranked := s.data.rankedUsers()
+	var rank *int
+	for i, u := range ranked {
+		if u.ID == user.ID {
+			r := i + 1
+			rank = &r
+		}
+	}
+
+	played := s.recentlyPlayed(user.Username)
+	played = played[:min(max(gamesCount, 0), len(played))]
+	recentlyPlayed := []models.GetUserSummaryRecentlyPlayed{}
+	awarded := map[string]models.GetUserSummaryAwarded{}
+	for _, g := range played {
+		recentlyPlayed = append(recentlyPlayed, models.GetUserSummaryRecentlyPlayed{
+			GameID:            g.GameID,
+			ConsoleID:         g.ConsoleID,
+			ConsoleName:       g.ConsoleName,
+			Title:             g.Title,
+			ImageIcon:         g.ImageIcon,
+			ImageTitle:        g.ImageTitle,
+			ImageIngame:       g.ImageIngame,
+			ImageBoxArt:       g.ImageBoxArt,
+			LastPlayed:        g.LastPlayed,
+			AchievementsTotal: g.AchievementsTotal,
+		})
+		awarded[strconv.Itoa(g.GameID)] = models.GetUserSummaryAwarded(userProgress(s.data.progress(user.Username, g.GameID)))
+	}
+
+	unlocks := s.data.userUnlocks(user.Username)
+	unlocks = unlocks[:min(max(achievementsCount, 0), len(unlocks))]
+	recent := models.GetUserSummaryRecentAchievements{}
+	for _, u := range unlocks {
+		a := s.data.achievement(u.AchievementID)
+		if a == nil {
+			continue
+		}
+		game := s.data.gameInfo(a.GameID)
+		gameKey := strconv.Itoa(game.ID)
+		if recent[gameKey] == nil {
+			recent[gameKey] = map[string]models.GetUserSummaryRecentAchievement{}
+		}
+		recent[gameKey][strconv.Itoa(a.ID)] = models.GetUserSummaryRecentAchievement{
+			ID:               a.ID,
+			GameID:           game.ID,
+			GameTitle:        game.Title,
+			Title:            a.Title,
+			Description:      a.Description,
+			Points:           a.Points,
+			Type:             stringOrNil(a.Type),
+			BadgeName:        a.BadgeName,
+			IsAwarded:        "1",
+			DateAwarded:      models.DateTime{Time: u.Date.UTC()},
+			HardcoreAchieved: boolInt(u.Hardcore),
+		}
+	}
+
+	var lastGame *models.GetUserSummaryLastGame
+	lastGameID := s.lastGameID(user.Username)
+	if game := s.data.game(lastGameID); game != nil {
+		lastGame = &models.GetUserSummaryLastGame{
+			ID:           game.ID,
+			Title:        game.Title,
+			ConsoleID:    game.ConsoleID,
+			ConsoleName:  s.data.console(game.ConsoleID).Name,
+			ForumTopicID: game.ForumTopicID,
+			ImageIcon:    gameImage(game.ID, 0),
+			ImageTitle:   gameImage(game.ID, 1),
+			ImageIngame:  gameImage(game.ID, 2),
+			ImageBoxArt:  gameImage(game.ID, 3),
+			Publisher:    stringOrNil(game.Publisher),
+			Developer:    stringOrNil(game.Developer),
+			Genre:        stringOrNil(game.Genre),
+			Released:     dateOrNil(game.Released),
+		}
+	}
+
+	return models.GetUserSummary{
+		User:                user.Username,
+		UserPic:             userPic(user.Username),
+		TotalRanked:         len(ranked),
+		Status:              "Offline",
+		RichPresenceMsg:     user.RichPresenceMsg,
+		LastGameID:          lastGameID,
+		ContribCount:        contribCount,
+		ContribYield:        contribYield,
+		TotalPoints:         points,
+		TotalSoftcorePoints: softcore,
+		TotalTruePoints:     retro,
+		Permissions:         user.Permissions,
+		Untracked:           boolInt(user.Untracked),
+		ID:                  user.ID,
+		UserWallActive:      1,
+		Motto:               user.Motto,
+		RecentlyPlayedCount: len(recentlyPlayed),
+		Rank:                rank,
+		MemberSince:         models.DateTime{Time: user.MemberSince.UTC()},
+		LastActivity: models.GetUserSummaryLastActivity{
+			User: user.Username,
+		},
+		RecentlyPlayed:     recentlyPlayed,
+		Awarded:            awarded,
+		RecentAchievements: recent,
+		LastGame:           lastGame,
+	}, nil
+}
+
+func (s *Server) getUserCompletedGames(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	games := []models.GetUserCompletedGames{}
+	for _, id := range s.data.playedGames(user.Username) {
+		game := s.data.gameInfo(id)
+		p := s.data.progress(user.Username, id)
+		entry := models.GetUserCompletedGames{
+			GameID:       game.ID,
+			Title:        game.Title,
+			ImageIcon:    gameImage(game.ID, 0),
+			ConsoleID:    game.ConsoleID,
+			ConsoleName:  s.data.console(game.ConsoleID).Name,
+			MaxPossible:  p.possible,
+			NumAwarded:   p.achieved,
+			PctWon:       ratio(p.achieved, p.possible),
+			HardcoreMode: "0",
+		}
+		games = append(games, entry)
+		if p.achievedHardcore > 0 {
+			entry.NumAwarded = p.achievedHardcore
+			entry.PctWon = ratio(p.achievedHardcore, p.possible)
+			entry.HardcoreMode = "1"
+			games = append(games, entry)
+		}
+	}
+	return games, nil
+}
+
+// ratio renders a completion ratio the way the API does
+func ratio(n int, total int) string {
+	if total == 0 {
+		return "0.0000"
+	}
+	return fmt.Sprintf("%.4f", float64(n)/float64(total))
+}
+
+func (s *Server) getUserWantToPlayList(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	all := []models.GetUserWantToPlayListResult{}
+	for _, id := range user.WantToPlay {
+		game := s.data.gameInfo(id)
+		achievements := s.data.gameAchievements(id, false)
+		points := 0
+		for _, a := range achievements {
+			points += a.Points
+		}
+		all = append(all, models.GetUserWantToPlayListResult{
+			ID:                    game.ID,
+			Title:                 game.Title,
+			ImageIcon:             gameImage(game.ID, 0),
+			ConsoleID:             game.ConsoleID,
+			ConsoleName:           s.data.console(game.ConsoleID).Name,
+			PointsTotal:           points,
+			AchievementsPublished: len(achievements),
+		})
+	}
+	page, err := paginate(q, all, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetUserWantToPlayList{
+		Count:   len(page),
+		Total:   len(all),
+		Results: page,
+	}, nil
+}
+
+// follows reports whether a user follows another one
+func (s *Server) follows(follower *User, username string) bool {
+	return containsFold(follower.Following, username)
+}
+
+func (s *Server) getUsersIFollow(caller *User, q url.Values) (any, error) {
+	all := []models.GetUsersIFollowResult{}
+	for _, name := range caller.Following {
+		u := s.data.user(name)
+		if u == nil {
+			continue
+		}
+		points, softcore, _ := s.data.points(u.Username)
+		all = append(all, models.GetUsersIFollowResult{
+			User:           u.Username,
+			Points:         points,
+			PointsSoftcore: softcore,
+			IsFollowingMe:  s.follows(u, caller.Username),
+		})
+	}
+	page, err := paginate(q, all, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetUsersIFollow{
+		Count:   len(page),
+		Total:   len(all),
+		Results: page,
+	}, nil
+}
+
+func (s *Server) getUsersFollowingMe(caller *User, q url.Values) (any, error) {
+	all := []models.GetUsersFollowingMeResult{}
+	for i := range s.data.Users {
+		u := &s.data.Users[i]
+		if !s.follows(u, caller.Username) {
+			continue
+		}
+		points, softcore, _ := s.data.points(u.Username)
+		all = append(all, models.GetUsersFollowingMeResult{
+			User:           u.Username,
+			Points:         points,
+			PointsSoftcore: softcore,
+			AmIFollowing:   s.follows(caller, u.Username),
+		})
+	}
+	page, err := paginate(q, all, 100, 500)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetUsersFollowingMe{
+		Count:   len(page),
+		Total:   len(all),
+		Results: page,
+	}, nil
+}
+
+// pointsPerSetRequest is how many points a user earns for every set request they can make
+const pointsPerSetRequest = 2500
+
+func (s *Server) getUserSetRequests(caller *User, q url.Values) (any, error) {
+	user, err := s.requiredUser(q)
+	if err != nil {
+		return nil, err
+	}
+	all := flag(q, "t")
+	requested := []models.GetUserSetRequestsRequestedSet{}
+	for _, id := range user.SetRequests {
+		if !all && len(s.data.gameAchievements(id, false)) > 0 {
+			continue
+		}
+		game := s.data.gameInfo(id)
+		requested = append(requested, models.GetUserSetRequestsRequestedSet{
+			GameID:      game.ID,
+			Title:       game.Title,
+			ImageIcon:   gameImage(game.ID, 0),
+			ConsoleID:   game.ConsoleID,
+			ConsoleName: s.data.console(game.ConsoleID).Name,
+		})
+	}
+	points, softcore, _ := s.data.points(user.Username)
+	total := points + softcore
+	return models.GetUserSetRequests{
+		TotalRequests: total/pointsPerSetRequest + 1,
+		PointsForNext: pointsPerSetRequest - total%pointsPerSetRequest,
+		RequestedSets: requested,
+	}, nil
+}
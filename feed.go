@@ -1,6 +1,8 @@
 package retroachievements
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,7 +13,17 @@ import (
 )
 
 // GetRecentGameAwards gets all recently granted game awards across the site's userbase.
+//
+// GetRecentGameAwards uses context.Background internally; to specify the context, use GetRecentGameAwardsContext.
 func (c *Client) GetRecentGameAwards(params models.GetRecentGameAwardsParameters) (*models.GetRecentGameAwards, error) {
+	return c.GetRecentGameAwardsContext(context.Background(), params)
+}
+
+// GetRecentGameAwardsContext gets all recently granted game awards across the site's userbase.
+func (c *Client) GetRecentGameAwardsContext(ctx context.Context, params models.GetRecentGameAwardsParameters) (*models.GetRecentGameAwards, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -41,16 +53,16 @@ func (c *Client) GetRecentGameAwards(params models.GetRecentGameAwardsParameters
 			if beatenHardcore {
 				k = append(k, "beaten-hardcore")
 			}
-			if beatenHardcore {
+			if completed {
 				k = append(k, "completed")
 			}
-			if beatenHardcore {
+			if mastered {
 				k = append(k, "mastered")
 			}
 			details = append(details, raHttp.K(k))
 		}
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -62,8 +74,19 @@ func (c *Client) GetRecentGameAwards(params models.GetRecentGameAwardsParameters
 }
 
 // GetActiveClaims gets information about all active set claims (max: 1000).
+//
+// GetActiveClaims uses context.Background internally; to specify the context, use GetActiveClaimsContext.
 func (c *Client) GetActiveClaims(params models.GetActiveClaimsParameters) ([]models.GetActiveClaims, error) {
+	return c.GetActiveClaimsContext(context.Background(), params)
+}
+
+// GetActiveClaimsContext gets information about all active set claims (max: 1000).
+func (c *Client) GetActiveClaimsContext(ctx context.Context, params models.GetActiveClaimsParameters) ([]models.GetActiveClaims, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetActiveClaims.php"),
@@ -80,7 +103,17 @@ func (c *Client) GetActiveClaims(params models.GetActiveClaimsParameters) ([]mod
 }
 
 // GetClaims gets information about all achievement set development claims of a specified kind: completed, dropped, or expired (max: 1000).
+//
+// GetClaims uses context.Background internally; to specify the context, use GetClaimsContext.
 func (c *Client) GetClaims(params models.GetClaimsParameters) ([]models.GetClaims, error) {
+	return c.GetClaimsContext(context.Background(), params)
+}
+
+// GetClaimsContext gets information about all achievement set development claims of a specified kind: completed, dropped, or expired (max: 1000).
+func (c *Client) GetClaimsContext(ctx context.Context, params models.GetClaimsParameters) ([]models.GetClaims, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -92,10 +125,21 @@ func (c *Client) GetClaims(params models.GetClaimsParameters) ([]models.GetClaim
 			strconv.Itoa(params.Kind.GetClaimsParametersKindID()),
 		}))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
+	if _, ok := params.Kind.(*models.GetClaimsParametersKindExpired); ok {
+		expired, err := raHttp.ResponseObject[models.ExpiredClaims](r)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("parsing response object: %w", err)
+		}
+		// an empty list is no expired claims rather than a missing resource
+		if expired == nil {
+			return []models.GetClaims{}, nil
+		}
+		return *expired, nil
+	}
 	resp, err := raHttp.ResponseList[models.GetClaims](r)
 	if err != nil {
 		return nil, fmt.Errorf("parsing response list: %w", err)
@@ -104,8 +148,19 @@ func (c *Client) GetClaims(params models.GetClaimsParameters) ([]models.GetClaim
 }
 
 // GetTopTenUsers gets the current top ten users, ranked by hardcore points, on the site.
+//
+// GetTopTenUsers uses context.Background internally; to specify the context, use GetTopTenUsersContext.
 func (c *Client) GetTopTenUsers(params models.GetTopTenUsersParameters) ([]models.GetTopTenUsers, error) {
+	return c.GetTopTenUsersContext(context.Background(), params)
+}
+
+// GetTopTenUsersContext gets the current top ten users, ranked by hardcore points, on the site.
+func (c *Client) GetTopTenUsersContext(ctx context.Context, params models.GetTopTenUsersParameters) ([]models.GetTopTenUsers, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetTopTenUsers.php"),
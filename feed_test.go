@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -51,7 +53,7 @@ func TestGetRecentGameAwards(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetRecentGameAwards, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetRecentGameAwards.php?c=10&d=2024-10-05&k=beaten-softcore%2Cbeaten-hardcore%2Ccompleted%2Cmastered&o=10&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetRecentGameAwards.php?c=10&d=2024-10-05&k=beaten-softcore%2Cbeaten-hardcore%2Ccompleted%2Cmastered&o=10&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -200,7 +202,7 @@ func TestGetActiveClaims(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetActiveClaims, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetActiveClaims.php?y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetActiveClaims.php?y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -349,7 +351,7 @@ func TestGetClaims(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetClaims, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetClaims.php?k=2&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetClaims.php?k=2&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -494,7 +496,7 @@ func TestGetTopTenUsers(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetTopTenUsers, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTopTenUsers.php?y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTopTenUsers.php?y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -585,3 +587,139 @@ func TestGetTopTenUsers(tt *testing.T) {
 		})
 	}
 }
+
+func TestGetRecentGameAwardsQuery(tt *testing.T) {
+	tests := []struct {
+		name     string
+		kinds    models.GetRecentGameAwardsParametersPartialAwards
+		expected string
+	}{
+		{
+			name:     "no kinds",
+			expected: "",
+		},
+		{
+			name:     "completed",
+			kinds:    models.GetRecentGameAwardsParametersPartialAwards{Completed: true},
+			expected: "k=completed",
+		},
+		{
+			name:     "mastered",
+			kinds:    models.GetRecentGameAwardsParametersPartialAwards{Mastered: true},
+			expected: "k=mastered",
+		},
+		{
+			name:     "beaten hardcore",
+			kinds:    models.GetRecentGameAwardsParametersPartialAwards{BeatenHardcore: true},
+			expected: "k=beaten-hardcore",
+		},
+		{
+			name:     "beaten softcore and mastered",
+			kinds:    models.GetRecentGameAwardsParametersPartialAwards{BeatenSoftcore: true, Mastered: true},
+			expected: "k=beaten-softcore%2Cmastered",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, capturedQuery(t, func(client *retroachievements.Client) error {
+				_, err := client.GetRecentGameAwards(models.GetRecentGameAwardsParameters{
+					IncludePartialAwards: &test.kinds,
+				})
+				return err
+			}))
+		})
+	}
+}
+
+func TestGetClaimsExpired(tt *testing.T) {
+	jamiras := models.GetClaims{
+		ID:          9012,
+		User:        "Jamiras",
+		GameID:      1234,
+		GameTitle:   "Pac-Man",
+		GameIcon:    "/Images/061592.png",
+		ConsoleID:   7,
+		ConsoleName: "NES/Famicom",
+		SetType:     1,
+		Extension:   2,
+		Created:     models.DateTime{Time: time.Date(2024, time.January, 5, 18, 30, 59, 0, time.UTC)},
+		DoneTime:    models.DateTime{Time: time.Date(2024, time.April, 5, 18, 30, 59, 0, time.UTC)},
+		MinutesLeft: -4320,
+	}
+	scott := models.GetClaims{
+		ID:          9013,
+		User:        "Scott",
+		GameID:      5678,
+		GameTitle:   "Tetris",
+		GameIcon:    "/Images/000001.png",
+		ConsoleID:   4,
+		ConsoleName: "Game Boy",
+		ClaimType:   1,
+		Created:     models.DateTime{Time: time.Date(2023, time.November, 2, 8, 0, 0, 0, time.UTC)},
+		DoneTime:    models.DateTime{Time: time.Date(2024, time.February, 2, 8, 0, 0, 0, time.UTC)},
+		UserIsJrDev: 1,
+	}
+	tests := []struct {
+		name     string
+		body     func(t *testing.T) []byte
+		expected []models.GetClaims
+	}{
+		{
+			name: "quoted numbers and RFC3339 dates",
+			body: fixture("claims/expired_list.json"),
+			expected: []models.GetClaims{
+				func() models.GetClaims {
+					c := jamiras
+					c.Updated = models.DateTime{Time: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)}
+					return c
+				}(),
+			},
+		},
+		{
+			name:     "object keyed by position",
+			body:     fixture("claims/expired_object.json"),
+			expected: []models.GetClaims{jamiras, scott},
+		},
+		{
+			name: "no expired claims",
+			body: func(t *testing.T) []byte {
+				return []byte("[]")
+			},
+			expected: []models.GetClaims{},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "3", r.URL.Query().Get("k"))
+				_, err := w.Write(test.body(t))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      server.URL,
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			}, retroachievements.NotFoundErrors())
+			claims, err := client.GetClaims(models.GetClaimsParameters{
+				Kind: &models.GetClaimsParametersKindExpired{},
+			})
+			require.NoError(t, err)
+			for i := range claims {
+				claims[i].Created.Time = claims[i].Created.UTC()
+				claims[i].DoneTime.Time = claims[i].DoneTime.UTC()
+				claims[i].Updated.Time = claims[i].Updated.UTC()
+			}
+			require.Equal(t, test.expected, claims)
+		})
+	}
+}
+
+// fixture reads a response body from the testdata directory
+func fixture(name string) func(t *testing.T) []byte {
+	return func(t *testing.T) []byte {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+		return data
+	}
+}
@@ -0,0 +1,77 @@
+package retroachievements
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache stores responses as files in a directory so they survive restarts
+type FileCache struct {
+	dir string
+}
+
+type fileCacheEntry struct {
+	Expires time.Time `json:"expires"`
+	Data    []byte    `json:"data"`
+}
+
+// NewFileCache creates a cache writing responses into dir, creating the directory if needed
+func NewFileCache(dir string) (*FileCache, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileCache{
+		dir: dir,
+	}, nil
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the stored body for a key, false if it is missing, expired or unreadable
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	path := f.path(key)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	entry := fileCacheEntry{}
+	err = json.Unmarshal(raw, &entry)
+	if err != nil || time.Now().After(entry.Expires) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	return entry.Data, true
+}
+
+// Set stores a body under a key for the given amount of time, write failures are ignored
+func (f *FileCache) Set(key string, data []byte, ttl time.Duration) {
+	raw, err := json.Marshal(fileCacheEntry{
+		Expires: time.Now().Add(ttl),
+		Data:    data,
+	})
+	if err != nil {
+		return
+	}
+	// write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(f.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(raw)
+	closeErr := tmp.Close()
+	if err != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), f.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,8 +11,19 @@ import (
 )
 
 // GetGame get basic metadata about a game.
+//
+// GetGame uses context.Background internally; to specify the context, use GetGameContext.
 func (c *Client) GetGame(params models.GetGameParameters) (*models.GetGame, error) {
+	return c.GetGameContext(context.Background(), params)
+}
+
+// GetGameContext get basic metadata about a game.
+func (c *Client) GetGameContext(ctx context.Context, params models.GetGameParameters) (*models.GetGame, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	resp, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetGame.php"),
@@ -29,7 +41,17 @@ func (c *Client) GetGame(params models.GetGameParameters) (*models.GetGame, erro
 }
 
 // GetGameExtended get extended metadata about a game.
+//
+// GetGameExtended uses context.Background internally; to specify the context, use GetGameExtendedContext.
 func (c *Client) GetGameExtended(params models.GetGameExtentedParameters) (*models.GetGameExtented, error) {
+	return c.GetGameExtendedContext(context.Background(), params)
+}
+
+// GetGameExtendedContext get extended metadata about a game.
+func (c *Client) GetGameExtendedContext(ctx context.Context, params models.GetGameExtentedParameters) (*models.GetGameExtented, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -44,7 +66,7 @@ func (c *Client) GetGameExtended(params models.GetGameExtentedParameters) (*mode
 		}
 		details = append(details, raHttp.F(f))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -56,8 +78,19 @@ func (c *Client) GetGameExtended(params models.GetGameExtentedParameters) (*mode
 }
 
 // GetGameHashes get the hashes linked to a game.
+//
+// GetGameHashes uses context.Background internally; to specify the context, use GetGameHashesContext.
 func (c *Client) GetGameHashes(params models.GetGameHashesParameters) (*models.GetGameHashes, error) {
+	return c.GetGameHashesContext(context.Background(), params)
+}
+
+// GetGameHashesContext get the hashes linked to a game.
+func (c *Client) GetGameHashesContext(ctx context.Context, params models.GetGameHashesParameters) (*models.GetGameHashes, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetGameHashes.php"),
@@ -75,8 +108,19 @@ func (c *Client) GetGameHashes(params models.GetGameHashesParameters) (*models.G
 }
 
 // GetAchievementCount the list of achievement IDs for a game.
+//
+// GetAchievementCount uses context.Background internally; to specify the context, use GetAchievementCountContext.
 func (c *Client) GetAchievementCount(params models.GetAchievementCountParameters) (*models.GetAchievementCount, error) {
+	return c.GetAchievementCountContext(context.Background(), params)
+}
+
+// GetAchievementCountContext the list of achievement IDs for a game.
+func (c *Client) GetAchievementCountContext(ctx context.Context, params models.GetAchievementCountParameters) (*models.GetAchievementCount, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetAchievementCount.php"),
@@ -94,7 +138,17 @@ func (c *Client) GetAchievementCount(params models.GetAchievementCountParameters
 }
 
 // GetAchievementDistribution gets how many players have unlocked how many achievements for a game.
+//
+// GetAchievementDistribution uses context.Background internally; to specify the context, use GetAchievementDistributionContext.
 func (c *Client) GetAchievementDistribution(params models.GetAchievementDistributionParameters) (*models.GetAchievementDistribution, error) {
+	return c.GetAchievementDistributionContext(context.Background(), params)
+}
+
+// GetAchievementDistributionContext gets how many players have unlocked how many achievements for a game.
+func (c *Client) GetAchievementDistributionContext(ctx context.Context, params models.GetAchievementDistributionParameters) (*models.GetAchievementDistribution, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -116,7 +170,7 @@ func (c *Client) GetAchievementDistribution(params models.GetAchievementDistribu
 		}
 		details = append(details, raHttp.H(h))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -128,7 +182,17 @@ func (c *Client) GetAchievementDistribution(params models.GetAchievementDistribu
 }
 
 // GetGameRankAndScore gets metadata about either the latest masters for a game, or the highest points earners for a game.
+//
+// GetGameRankAndScore uses context.Background internally; to specify the context, use GetGameRankAndScoreContext.
 func (c *Client) GetGameRankAndScore(params models.GetGameRankAndScoreParameters) ([]models.GetGameRankAndScore, error) {
+	return c.GetGameRankAndScoreContext(context.Background(), params)
+}
+
+// GetGameRankAndScoreContext gets metadata about either the latest masters for a game, or the highest points earners for a game.
+func (c *Client) GetGameRankAndScoreContext(ctx context.Context, params models.GetGameRankAndScoreParameters) ([]models.GetGameRankAndScore, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -143,7 +207,7 @@ func (c *Client) GetGameRankAndScore(params models.GetGameRankAndScoreParameters
 		}
 		details = append(details, raHttp.T(t))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -153,3 +217,40 @@ func (c *Client) GetGameRankAndScore(params models.GetGameRankAndScoreParameters
 	}
 	return resp, nil
 }
+
+// GetGameProgression gets the median times players take to beat, complete and master a game, and to unlock each of its achievements.
+//
+// GetGameProgression uses context.Background internally; to specify the context, use GetGameProgressionContext.
+func (c *Client) GetGameProgression(params models.GetGameProgressionParameters) (*models.GetGameProgression, error) {
+	return c.GetGameProgressionContext(context.Background(), params)
+}
+
+// GetGameProgressionContext gets the median times players take to beat, complete and master a game, and to unlock each of its achievements.
+func (c *Client) GetGameProgressionContext(ctx context.Context, params models.GetGameProgressionParameters) (*models.GetGameProgression, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
+	details := []raHttp.RequestDetail{
+		raHttp.Method(http.MethodGet),
+		raHttp.UserAgent(c.UserAgent),
+		raHttp.Path("/API/API_GetGameProgression.php"),
+		raHttp.Y(c.APISecret),
+		raHttp.I([]string{strconv.Itoa(params.GameID)}),
+	}
+	if params.Hardcore != nil {
+		h := 0
+		if *params.Hardcore {
+			h = 1
+		}
+		details = append(details, raHttp.H(h))
+	}
+	r, err := c.do(ctx, details...)
+	if err != nil {
+		return nil, fmt.Errorf("calling endpoint: %w", err)
+	}
+	resp, err := raHttp.ResponseObject[models.GetGameProgression](r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response object: %w", err)
+	}
+	return resp, nil
+}
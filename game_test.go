@@ -61,7 +61,7 @@ func TestGetGame(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetGame, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGame.php?i=2991&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGame.php?i=2991&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -256,7 +256,7 @@ func TestGetGameExtended(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetGameExtented, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameExtended.php?f=5&i=2991&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameExtended.php?f=5&i=2991&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -442,7 +442,7 @@ func TestGetGameHashes(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetGameHashes, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameHashes.php?i=2991&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameHashes.php?i=2991&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -565,7 +565,7 @@ func TestGetAchievementCount(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetAchievementCount, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementCount.php?i=14402&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementCount.php?i=14402&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -709,7 +709,7 @@ func TestGetAchievementDistribution(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetAchievementDistribution, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementDistribution.php?f=5&h=1&i=14402&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementDistribution.php?f=5&h=1&i=14402&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -885,7 +885,7 @@ func TestGetGameRankAndScore(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetGameRankAndScore, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameRankAndScore.php?g=14402&t=1&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameRankAndScore.php?g=14402&t=1&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -990,3 +990,193 @@ func TestGetGameRankAndScore(tt *testing.T) {
 		})
 	}
 }
+
+func TestGetGameProgression(tt *testing.T) {
+	hardcore := true
+	softcore := false
+	medianTimeToBeat := 17878
+	medianTimeToBeatHardcore := 19224
+	medianTimeToComplete := 67017
+	medianTimeToMaster := 79744
+	medianTimeToUnlock := 274
+	medianTimeToUnlockHardcore := 323
+	tests := []struct {
+		name            string
+		params          models.GetGameProgressionParameters
+		modifyURL       func(url string) string
+		responseCode    int
+		responseMessage models.GetGameProgression
+		responseError   models.ErrorResponse
+		response        func(messageBytes []byte, errorBytes []byte) []byte
+		assert          func(t *testing.T, resp *models.GetGameProgression, err error)
+	}{
+		{
+			name: "fail to call endpoint",
+			params: models.GetGameProgressionParameters{
+				GameID:   228,
+				Hardcore: &hardcore,
+			},
+			modifyURL: func(url string) string {
+				return ""
+			},
+			responseCode: http.StatusOK,
+			response: func(messageBytes []byte, errorBytes []byte) []byte {
+				return messageBytes
+			},
+			assert: func(t *testing.T, resp *models.GetGameProgression, err error) {
+				require.Nil(t, resp)
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameProgression.php?h=1&i=228&y=REDACTED\": unsupported protocol scheme \"\"")
+			},
+		},
+		{
+			name: "error response",
+			params: models.GetGameProgressionParameters{
+				GameID:   228,
+				Hardcore: &softcore,
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusUnauthorized,
+			responseError: models.ErrorResponse{
+				Message: "test",
+				Errors: []models.ErrorDetail{
+					{
+						Status: http.StatusUnauthorized,
+						Code:   "unauthorized",
+						Title:  "Not Authorized",
+					},
+				},
+			},
+			response: func(messageBytes []byte, errorBytes []byte) []byte {
+				return errorBytes
+			},
+			assert: func(t *testing.T, resp *models.GetGameProgression, err error) {
+				require.Nil(t, resp)
+				require.EqualError(t, err, "parsing response object: error code 401 returned: {\"message\":\"test\",\"errors\":[{\"status\":401,\"code\":\"unauthorized\",\"title\":\"Not Authorized\"}]}")
+			},
+		},
+		{
+			name: "game not found",
+			params: models.GetGameProgressionParameters{
+				GameID: 999999,
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusOK,
+			response: func(messageBytes []byte, errorBytes []byte) []byte {
+				return []byte("[]")
+			},
+			assert: func(t *testing.T, resp *models.GetGameProgression, err error) {
+				require.Nil(t, resp)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "success",
+			params: models.GetGameProgressionParameters{
+				GameID: 228,
+			},
+			modifyURL: func(url string) string {
+				return url
+			},
+			responseCode: http.StatusOK,
+			responseMessage: models.GetGameProgression{
+				ID:                            228,
+				Title:                         "Super Mario World",
+				ConsoleID:                     3,
+				ConsoleName:                   "SNES/Super Famicom",
+				ImageIcon:                     "/Images/112443.png",
+				NumDistinctPlayers:            79281,
+				TimesUsedInBeatMedian:         4493,
+				TimesUsedInHardcoreBeatMedian: 8249,
+				MedianTimeToBeat:              &medianTimeToBeat,
+				MedianTimeToBeatHardcore:      &medianTimeToBeatHardcore,
+				TimesUsedInCompletionMedian:   155,
+				TimesUsedInMasteryMedian:      1091,
+				MedianTimeToComplete:          &medianTimeToComplete,
+				MedianTimeToMaster:            &medianTimeToMaster,
+				NumAchievements:               2,
+				Achievements: []models.GetGameProgressionAchievement{
+					{
+						ID:                              342,
+						Title:                           "Giddy Up!",
+						Description:                     "Catch a ride with a friend",
+						Points:                          1,
+						TrueRatio:                       1,
+						BadgeName:                       "46580",
+						NumAwarded:                      75168,
+						NumAwardedHardcore:              37767,
+						TimesUsedInUnlockMedian:         63,
+						TimesUsedInHardcoreUnlockMedian: 69,
+						MedianTimeToUnlock:              &medianTimeToUnlock,
+						MedianTimeToUnlockHardcore:      &medianTimeToUnlockHardcore,
+					},
+					{
+						ID:          343,
+						Title:       "Unlocked by nobody",
+						Description: "No one has this yet",
+						Points:      50,
+						TrueRatio:   0,
+						BadgeName:   "46581",
+					},
+				},
+			},
+			response: func(messageBytes []byte, errorBytes []byte) []byte {
+				return messageBytes
+			},
+			assert: func(t *testing.T, resp *models.GetGameProgression, err error) {
+				require.NotNil(t, resp)
+				require.Equal(t, 228, resp.ID)
+				require.Equal(t, "Super Mario World", resp.Title)
+				require.Equal(t, 79281, resp.NumDistinctPlayers)
+				require.NotNil(t, resp.MedianTimeToBeat)
+				require.Equal(t, 17878, *resp.MedianTimeToBeat)
+				require.NotNil(t, resp.MedianTimeToBeatHardcore)
+				require.Equal(t, 19224, *resp.MedianTimeToBeatHardcore)
+				require.NotNil(t, resp.MedianTimeToComplete)
+				require.Equal(t, 67017, *resp.MedianTimeToComplete)
+				require.NotNil(t, resp.MedianTimeToMaster)
+				require.Equal(t, 79744, *resp.MedianTimeToMaster)
+				require.Len(t, resp.Achievements, 2)
+				require.Equal(t, "Giddy Up!", resp.Achievements[0].Title)
+				require.Nil(t, resp.Achievements[0].Type)
+				require.NotNil(t, resp.Achievements[0].MedianTimeToUnlock)
+				require.Equal(t, 274, *resp.Achievements[0].MedianTimeToUnlock)
+				require.NotNil(t, resp.Achievements[0].MedianTimeToUnlockHardcore)
+				require.Equal(t, 323, *resp.Achievements[0].MedianTimeToUnlockHardcore)
+				require.Nil(t, resp.Achievements[1].MedianTimeToUnlock)
+				require.Nil(t, resp.Achievements[1].MedianTimeToUnlockHardcore)
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				expectedPath := "/API/API_GetGameProgression.php"
+				if r.URL.Path != expectedPath {
+					t.Errorf("Expected to request '%s', got: %s", expectedPath, r.URL.Path)
+				}
+				w.WriteHeader(test.responseCode)
+				messageBytes, err := json.Marshal(test.responseMessage)
+				require.NoError(t, err)
+				errBytes, err := json.Marshal(test.responseError)
+				require.NoError(t, err)
+				resp := test.response(messageBytes, errBytes)
+				num, err := w.Write(resp)
+				require.NoError(t, err)
+				require.Equal(t, num, len(resp))
+			}))
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      test.modifyURL(server.URL),
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			})
+			resp, err := client.GetGameProgression(test.params)
+			test.assert(t, resp, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+var (
+	// ErrUnauthorized matches API errors caused by a missing or invalid API key
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrRateLimited matches API errors caused by too many requests
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrValidation matches API errors caused by invalid request parameters
+	ErrValidation = errors.New("validation failed")
+
+	// ErrServer matches API errors caused by a problem on the server
+	ErrServer = errors.New("server error")
+)
+
+// APIError is returned when the API responds with an unexpected status code
+type APIError struct {
+	// HTTP response code status
+	StatusCode int
+
+	// Readable problem returned from the API, if any
+	Message string
+
+	// Specific errors returned from the API, if any
+	Details []models.ErrorDetail
+
+	// Errors for each invalid request parameter, keyed by parameter name
+	FieldErrors map[string][]string
+
+	// Raw response body
+	Body []byte
+}
+
+// NewAPIError builds an API error from a status code and response body, the body
+// is parsed on a best effort basis so unknown formats are kept only in Body
+func NewAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+	resp := struct {
+		Message string          `json:"message"`
+		Errors  json.RawMessage `json:"errors"`
+	}{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return apiErr
+	}
+	apiErr.Message = resp.Message
+	errs := bytes.TrimSpace(resp.Errors)
+	if len(errs) == 0 {
+		return apiErr
+	}
+	switch errs[0] {
+	case '[':
+		details := []models.ErrorDetail{}
+		if err := json.Unmarshal(errs, &details); err == nil {
+			apiErr.Details = details
+		}
+	case '{':
+		fields := map[string][]string{}
+		if err := json.Unmarshal(errs, &fields); err == nil {
+			apiErr.FieldErrors = fields
+		}
+	}
+	return apiErr
+}
+
+// Error returns the status code along with the raw response body
+func (e *APIError) Error() string {
+	return fmt.Sprintf("error code %d returned: %s", e.StatusCode, string(e.Body))
+}
+
+// Is reports whether the error falls into the class of the target sentinel error
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity || e.StatusCode == http.StatusBadRequest
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIError(tt *testing.T) {
+	tests := []struct {
+		name   string
+		code   int
+		body   string
+		assert func(t *testing.T, err *raHttp.APIError)
+	}{
+		{
+			name: "unknown body",
+			code: http.StatusBadGateway,
+			body: "<html>bad gateway</html>",
+			assert: func(t *testing.T, err *raHttp.APIError) {
+				require.Equal(t, http.StatusBadGateway, err.StatusCode)
+				require.Empty(t, err.Message)
+				require.Nil(t, err.Details)
+				require.Nil(t, err.FieldErrors)
+				require.Equal(t, []byte("<html>bad gateway</html>"), err.Body)
+				require.EqualError(t, err, "error code 502 returned: <html>bad gateway</html>")
+				require.ErrorIs(t, err, raHttp.ErrServer)
+				require.NotErrorIs(t, err, raHttp.ErrUnauthorized)
+			},
+		},
+		{
+			name: "error details",
+			code: http.StatusUnauthorized,
+			body: `{"message":"test","errors":[{"status":401,"code":"unauthorized","title":"Not Authorized"}]}`,
+			assert: func(t *testing.T, err *raHttp.APIError) {
+				require.Equal(t, http.StatusUnauthorized, err.StatusCode)
+				require.Equal(t, "test", err.Message)
+				require.Equal(t, []models.ErrorDetail{
+					{
+						Status: http.StatusUnauthorized,
+						Code:   "unauthorized",
+						Title:  "Not Authorized",
+					},
+				}, err.Details)
+				require.Nil(t, err.FieldErrors)
+				require.ErrorIs(t, err, raHttp.ErrUnauthorized)
+				require.NotErrorIs(t, err, raHttp.ErrServer)
+			},
+		},
+		{
+			name: "field errors",
+			code: http.StatusUnprocessableEntity,
+			body: `{"message":"The u field is required.","errors":{"u":["The u field is required."]}}`,
+			assert: func(t *testing.T, err *raHttp.APIError) {
+				require.Equal(t, "The u field is required.", err.Message)
+				require.Nil(t, err.Details)
+				require.Equal(t, map[string][]string{
+					"u": {"The u field is required."},
+				}, err.FieldErrors)
+				require.ErrorIs(t, err, raHttp.ErrValidation)
+				require.NotErrorIs(t, err, raHttp.ErrRateLimited)
+			},
+		},
+		{
+			name: "rate limited",
+			code: http.StatusTooManyRequests,
+			body: `{"message":"Too Many Attempts."}`,
+			assert: func(t *testing.T, err *raHttp.APIError) {
+				require.Equal(t, "Too Many Attempts.", err.Message)
+				require.ErrorIs(t, err, raHttp.ErrRateLimited)
+				require.NotErrorIs(t, err, raHttp.ErrValidation)
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			test.assert(t, raHttp.NewAPIError(test.code, []byte(test.body)))
+		})
+	}
+}
+
+func TestResponseObjectAPIError(t *testing.T) {
+	_, err := raHttp.ResponseObject[testObj](&raHttp.Response{
+		StatusCode: http.StatusForbidden,
+		Data:       []byte(`{"message":"forbidden"}`),
+	})
+	apiErr := &raHttp.APIError{}
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	require.Equal(t, "forbidden", apiErr.Message)
+	require.ErrorIs(t, err, raHttp.ErrUnauthorized)
+}
@@ -1,7 +1,12 @@
 package http
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -13,6 +18,30 @@ type Request struct {
 	Method  string
 	Params  map[string]string
 	Headers map[string]string
+
+	// Query holds query parameters sent in addition to Params, a key can have several values
+	Query url.Values
+
+	// Form holds the fields of a form encoded body, or the non file fields of a multipart body when Parts is set
+	Form url.Values
+
+	// Parts holds the files of a multipart body
+	Parts []Part
+}
+
+// Part is a file sent in a multipart body
+type Part struct {
+	// Name of the form field
+	Field string
+
+	// Name of the file reported to the server
+	FileName string
+
+	// MIME type of the file, application/octet-stream when empty
+	ContentType string
+
+	// Content of the file
+	Data []byte
 }
 
 type RequestDetail interface {
@@ -129,6 +158,13 @@ func R(r string) RequestDetail {
 	})
 }
 
+// Sort adds a 'sort' string to the query parameters
+func Sort(sort string) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		req.Params["sort"] = sort
+	})
+}
+
 // T adds a 't' string to the query parameters
 func T(t string) RequestDetail {
 	return requestDetailFn(func(req *Request) {
@@ -150,6 +186,68 @@ func Y(y string) RequestDetail {
 	})
 }
 
+// Param adds an arbitrary key and value to the query parameters
+func Param(key string, value string) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		req.Params[key] = value
+	})
+}
+
+// QueryValue adds a value to a query parameter, keeping the values already added under the same key
+func QueryValue(key string, value string) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		if req.Query == nil {
+			req.Query = url.Values{}
+		}
+		req.Query.Add(key, value)
+	})
+}
+
+// QueryValues adds every value of values to the query parameters
+func QueryValues(values url.Values) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		if req.Query == nil {
+			req.Query = url.Values{}
+		}
+		for k, vs := range values {
+			for _, v := range vs {
+				req.Query.Add(k, v)
+			}
+		}
+	})
+}
+
+// FormValue adds a field to the request body, which is form encoded unless files are added with FilePart
+func FormValue(key string, value string) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		if req.Form == nil {
+			req.Form = url.Values{}
+		}
+		req.Form.Add(key, value)
+	})
+}
+
+// FormValues adds every value of values to the request body
+func FormValues(values url.Values) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		if req.Form == nil {
+			req.Form = url.Values{}
+		}
+		for k, vs := range values {
+			for _, v := range vs {
+				req.Form.Add(k, v)
+			}
+		}
+	})
+}
+
+// FilePart adds a file to the request body, making it a multipart body
+func FilePart(part Part) RequestDetail {
+	return requestDetailFn(func(req *Request) {
+		req.Parts = append(req.Parts, part)
+	})
+}
+
 // Path adds a URL path to the host
 func Path(path string) RequestDetail {
 	return requestDetailFn(func(req *Request) {
@@ -169,3 +267,204 @@ func NewRequest(host string, details ...RequestDetail) *Request {
 	}
 	return request
 }
+
+// Values returns the query parameters of the request, merging Params and Query
+func (r *Request) Values() url.Values {
+	q := url.Values{}
+	for k, v := range r.Params {
+		q.Set(k, v)
+	}
+	for k, vs := range r.Query {
+		for _, v := range vs {
+			q.Add(k, v)
+		}
+	}
+	return q
+}
+
+// URL returns the full URL the request is sent to, including its query parameters
+func (r *Request) URL() string {
+	u := r.Host + r.Path
+	q := r.Values()
+	if len(q) == 0 {
+		return u
+	}
+	return u + "?" + q.Encode()
+}
+
+// HasBody reports whether the request sends a body
+func (r *Request) HasBody() bool {
+	return len(r.Form) > 0 || len(r.Parts) > 0
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// Body encodes the request body, returning the body and its content type. The body is multipart when
+// the request has Parts, form encoded when it only has Form values and nil when it has neither.
+func (r *Request) Body() ([]byte, string, error) {
+	if len(r.Parts) == 0 {
+		if len(r.Form) == 0 {
+			return nil, "", nil
+		}
+		return []byte(r.Form.Encode()), "application/x-www-form-urlencoded", nil
+	}
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	for k, vs := range r.Form {
+		for _, v := range vs {
+			err := w.WriteField(k, v)
+			if err != nil {
+				return nil, "", fmt.Errorf("writing form field %s: %w", k, err)
+			}
+		}
+	}
+	for _, part := range r.Parts {
+		contentType := part.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(part.Field), quoteEscaper.Replace(part.FileName)))
+		header.Set("Content-Type", contentType)
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, "", fmt.Errorf("creating file part %s: %w", part.Field, err)
+		}
+		_, err = io.Copy(pw, bytes.NewReader(part.Data))
+		if err != nil {
+			return nil, "", fmt.Errorf("writing file part %s: %w", part.Field, err)
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		return nil, "", fmt.Errorf("closing multipart body: %w", err)
+	}
+	return buf.Bytes(), w.FormDataContentType(), nil
+}
+
+// Redaction replaces secret values in redacted requests, URLs and errors
+const Redaction = "REDACTED"
+
+// secretParams returns the query parameter names holding secrets for a path, the Connect API
+// uses 't' for the session token and 'p' for the password where the web API uses them for regular values
+func secretParams(path string) []string {
+	if strings.HasSuffix(path, "/dorequest.php") {
+		return []string{"y", "t", "p"}
+	}
+	return []string{"y"}
+}
+
+// Redacted returns a copy of the request with the API key, Connect credentials and authorization header hidden,
+// safe to be logged or dumped
+func (r *Request) Redacted() *Request {
+	redacted := &Request{
+		Host:    r.Host,
+		Path:    r.Path,
+		Method:  r.Method,
+		Params:  make(map[string]string, len(r.Params)),
+		Headers: make(map[string]string, len(r.Headers)),
+		Query:   redactValues(r.Query, r.Path),
+		Form:    redactValues(r.Form, r.Path),
+		Parts:   r.Parts,
+	}
+	for k, v := range r.Params {
+		redacted.Params[k] = v
+	}
+	for k, v := range r.Headers {
+		redacted.Headers[k] = v
+	}
+	for _, k := range secretParams(r.Path) {
+		if _, ok := redacted.Params[k]; ok {
+			redacted.Params[k] = Redaction
+		}
+	}
+	if _, ok := redacted.Headers["Authorization"]; ok {
+		redacted.Headers["Authorization"] = Redaction
+	}
+	return redacted
+}
+
+// Secrets returns the secret values held by the request
+func (r *Request) Secrets() []string {
+	secrets := []string{}
+	for _, k := range secretParams(r.Path) {
+		if v := r.Params[k]; v != "" {
+			secrets = append(secrets, v)
+		}
+		for _, values := range []url.Values{r.Query, r.Form} {
+			for _, v := range values[k] {
+				if v != "" {
+					secrets = append(secrets, v)
+				}
+			}
+		}
+	}
+	if v := r.Headers["Authorization"]; v != "" {
+		secrets = append(secrets, strings.TrimPrefix(v, "Bearer "))
+	}
+	return secrets
+}
+
+// redactValues returns a copy of values with the secrets of the path hidden
+func redactValues(values url.Values, path string) url.Values {
+	if values == nil {
+		return nil
+	}
+	redacted := make(url.Values, len(values))
+	for k, vs := range values {
+		redacted[k] = append([]string(nil), vs...)
+	}
+	for _, k := range secretParams(path) {
+		for i := range redacted[k] {
+			redacted[k][i] = Redaction
+		}
+	}
+	return redacted
+}
+
+// RedactURL hides the API key and Connect credentials in the query of a URL, returning it unchanged if it can not be parsed
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	changed := false
+	for _, k := range secretParams(u.Path) {
+		if q.Has(k) {
+			q.Set(k, Redaction)
+			changed = true
+		}
+	}
+	if !changed {
+		return rawURL
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// RedactForm hides the API key and Connect credentials in a URL encoded request body sent to path, returning
+// it unchanged if it can not be parsed or holds no secrets
+func RedactForm(path string, body []byte) []byte {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+	changed := false
+	for _, k := range secretParams(path) {
+		if form.Has(k) {
+			form.Set(k, Redaction)
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	return []byte(form.Encode())
+}
+
+// IsConnectLogin reports whether a request of the given kind sent to path logs into the Connect API, the
+// response of such a request holds a session token
+func IsConnectLogin(path string, kind string) bool {
+	return strings.HasSuffix(path, "/dorequest.php") && (kind == "login" || kind == "login2")
+}
@@ -1,7 +1,12 @@
 package http_test
 
 import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strconv"
 	"testing"
 	"time"
@@ -31,6 +36,7 @@ func TestNewRequest(t *testing.T) {
 		raHttp.M(10),
 		raHttp.O(34),
 		raHttp.R("codenotes2"),
+		raHttp.Sort("-submitted"),
 		raHttp.T(strconv.Itoa(int(later.Unix()))),
 		raHttp.U("myUsername"),
 		raHttp.Y("secret_token"),
@@ -45,22 +51,185 @@ func TestNewRequest(t *testing.T) {
 			"User-Agent":    "go-retroachievements/v0.0.0",
 		},
 		Params: map[string]string{
-			"a": "1",
-			"c": "20",
-			"d": "2024-03-02",
-			"f": "1709400423",
-			"g": "345",
-			"h": "1",
-			"i": "2837,4535",
-			"k": "test1,test2",
-			"m": "10",
-			"o": "34",
-			"r": "codenotes2",
-			"t": "1709401023",
-			"u": "myUsername",
-			"y": "secret_token",
+			"a":    "1",
+			"c":    "20",
+			"d":    "2024-03-02",
+			"f":    "1709400423",
+			"g":    "345",
+			"h":    "1",
+			"i":    "2837,4535",
+			"k":    "test1,test2",
+			"m":    "10",
+			"o":    "34",
+			"r":    "codenotes2",
+			"sort": "-submitted",
+			"t":    "1709401023",
+			"u":    "myUsername",
+			"y":    "secret_token",
 		},
 	}
 
 	require.Equal(t, expected, actual)
 }
+
+func TestRedacted(t *testing.T) {
+	req := raHttp.NewRequest(
+		"http://localhost",
+		raHttp.Path("/API/API_GetComments.php"),
+		raHttp.BearerToken("secret_bearer"),
+		raHttp.T("2"),
+		raHttp.U("myUsername"),
+		raHttp.Y("secret_token"),
+	)
+	redacted := req.Redacted()
+	require.Equal(t, map[string]string{
+		"t": "2",
+		"u": "myUsername",
+		"y": "REDACTED",
+	}, redacted.Params)
+	require.Equal(t, map[string]string{
+		"Authorization": "REDACTED",
+	}, redacted.Headers)
+	require.Equal(t, "secret_token", req.Params["y"])
+	require.Equal(t, []string{"secret_token", "secret_bearer"}, req.Secrets())
+
+	connect := raHttp.NewRequest(
+		"http://localhost",
+		raHttp.Path("/dorequest.php"),
+		raHttp.R("login2"),
+		raHttp.T("session_token"),
+		raHttp.U("myUsername"),
+	)
+	require.Equal(t, map[string]string{
+		"r": "login2",
+		"t": "REDACTED",
+		"u": "myUsername",
+	}, connect.Redacted().Params)
+	require.Equal(t, []string{"session_token"}, connect.Secrets())
+}
+
+func TestRedactURL(t *testing.T) {
+	require.Equal(t, "http://localhost/API/API_GetGame.php?i=1&y=REDACTED", raHttp.RedactURL("http://localhost/API/API_GetGame.php?i=1&y=secret"))
+	require.Equal(t, "/API/API_GetComments.php?t=2&y=REDACTED", raHttp.RedactURL("/API/API_GetComments.php?t=2&y=secret"))
+	require.Equal(t, "/dorequest.php?p=REDACTED&r=login2&u=jamiras", raHttp.RedactURL("/dorequest.php?p=password&r=login2&u=jamiras"))
+	require.Equal(t, "/API/API_GetGame.php?i=1", raHttp.RedactURL("/API/API_GetGame.php?i=1"))
+	require.Equal(t, "%%", raHttp.RedactURL("%%"))
+}
+
+func TestRedactForm(t *testing.T) {
+	require.Equal(t, "p=REDACTED&r=login2&u=jamiras", string(raHttp.RedactForm("/dorequest.php", []byte("r=login2&u=jamiras&p=password"))))
+	require.Equal(t, "r=login2&t=REDACTED&u=jamiras", string(raHttp.RedactForm("/dorequest.php", []byte("r=login2&u=jamiras&t=token"))))
+	require.Equal(t, "i=1&t=2", string(raHttp.RedactForm("/API/API_GetComments.php", []byte("i=1&t=2"))))
+	require.Equal(t, "%%", string(raHttp.RedactForm("/dorequest.php", []byte("%%"))))
+}
+
+func TestIsConnectLogin(t *testing.T) {
+	require.True(t, raHttp.IsConnectLogin("/dorequest.php", "login2"))
+	require.True(t, raHttp.IsConnectLogin("/dorequest.php", "login"))
+	require.False(t, raHttp.IsConnectLogin("/dorequest.php", "codenotes2"))
+	require.False(t, raHttp.IsConnectLogin("/API/API_GetGame.php", "login2"))
+}
+
+func TestRequestURL(t *testing.T) {
+	require.Equal(t, "http://localhost/API/API_GetGame.php?i=1&y=secret", raHttp.NewRequest("http://localhost", raHttp.Path("/API/API_GetGame.php"), raHttp.I([]string{"1"}), raHttp.Y("secret")).URL())
+	require.Equal(t, "http://localhost/API/API_GetTopTenUsers.php", raHttp.NewRequest("http://localhost", raHttp.Path("/API/API_GetTopTenUsers.php")).URL())
+	require.Equal(t, "http://localhost/dorequest.php?f=1&f=2&g=3&r=achievementsets", raHttp.NewRequest(
+		"http://localhost",
+		raHttp.Path("/dorequest.php"),
+		raHttp.R("achievementsets"),
+		raHttp.QueryValue("f", "1"),
+		raHttp.QueryValues(url.Values{"f": {"2"}, "g": {"3"}}),
+	).URL())
+}
+
+func TestRequestBody(tt *testing.T) {
+	tests := []struct {
+		name    string
+		details []raHttp.RequestDetail
+		assert  func(t *testing.T, body []byte, contentType string, err error)
+	}{
+		{
+			name: "no body",
+			details: []raHttp.RequestDetail{
+				raHttp.U("myUsername"),
+			},
+			assert: func(t *testing.T, body []byte, contentType string, err error) {
+				require.NoError(t, err)
+				require.Nil(t, body)
+				require.Empty(t, contentType)
+			},
+		},
+		{
+			name: "form",
+			details: []raHttp.RequestDetail{
+				raHttp.FormValue("n", "some note"),
+				raHttp.FormValues(url.Values{"m": {"0x1234", "0x5678"}}),
+			},
+			assert: func(t *testing.T, body []byte, contentType string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "application/x-www-form-urlencoded", contentType)
+				require.Equal(t, "m=0x1234&m=0x5678&n=some+note", string(body))
+			},
+		},
+		{
+			name: "multipart",
+			details: []raHttp.RequestDetail{
+				raHttp.FormValue("r", "uploadbadgeimage"),
+				raHttp.FilePart(raHttp.Part{
+					Field:       "file",
+					FileName:    "badge.png",
+					ContentType: "image/png",
+					Data:        []byte("png data"),
+				}),
+				raHttp.FilePart(raHttp.Part{
+					Field:    "raw",
+					FileName: `say "hi".bin`,
+					Data:     []byte{0, 1},
+				}),
+			},
+			assert: func(t *testing.T, body []byte, contentType string, err error) {
+				require.NoError(t, err)
+				mediaType, params, err := mime.ParseMediaType(contentType)
+				require.NoError(t, err)
+				require.Equal(t, "multipart/form-data", mediaType)
+				form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+				require.NoError(t, err)
+				require.Equal(t, map[string][]string{"r": {"uploadbadgeimage"}}, form.Value)
+				require.Len(t, form.File["file"], 1)
+				require.Equal(t, "badge.png", form.File["file"][0].Filename)
+				require.Equal(t, "image/png", form.File["file"][0].Header.Get("Content-Type"))
+				require.Equal(t, `say "hi".bin`, form.File["raw"][0].Filename)
+				require.Equal(t, "application/octet-stream", form.File["raw"][0].Header.Get("Content-Type"))
+				f, err := form.File["file"][0].Open()
+				require.NoError(t, err)
+				data, err := io.ReadAll(f)
+				require.NoError(t, err)
+				require.Equal(t, "png data", string(data))
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			req := raHttp.NewRequest("http://localhost", test.details...)
+			require.Equal(t, test.name != "no body", req.HasBody())
+			body, contentType, err := req.Body()
+			test.assert(t, body, contentType, err)
+		})
+	}
+}
+
+func TestRedactedBody(t *testing.T) {
+	req := raHttp.NewRequest(
+		"http://localhost",
+		raHttp.Path("/dorequest.php"),
+		raHttp.R("login2"),
+		raHttp.FormValue("u", "myUsername"),
+		raHttp.FormValue("p", "password"),
+		raHttp.QueryValue("t", "session_token"),
+	)
+	redacted := req.Redacted()
+	require.Equal(t, url.Values{"u": {"myUsername"}, "p": {"REDACTED"}}, redacted.Form)
+	require.Equal(t, url.Values{"t": {"REDACTED"}}, redacted.Query)
+	require.Equal(t, url.Values{"p": {"password"}, "u": {"myUsername"}}, req.Form)
+	require.ElementsMatch(t, []string{"session_token", "password"}, req.Secrets())
+}
@@ -1,68 +1,287 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"sync"
 )
 
+var (
+	// ErrNotFound is returned when the requested resource does not exist and the response asks for strict not found handling
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrResponseTooLarge is returned when a response body is bigger than the allowed maximum size
+	ErrResponseTooLarge = errors.New("response too large")
+)
+
+// ReadBody reads a response body in a single buffer sized from the content length,
+// failing with ErrResponseTooLarge as soon as more than limit bytes are sent.
+func ReadBody(body io.Reader, contentLength int64, limit int64) ([]byte, error) {
+	err := checkContentLength(contentLength, limit)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if contentLength > 0 {
+		buf.Grow(int(contentLength) + bytes.MinRead)
+	}
+	n, err := buf.ReadFrom(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > limit {
+		return nil, fmt.Errorf("%w: body exceeds %d bytes", ErrResponseTooLarge, limit)
+	}
+	return buf.Bytes(), nil
+}
+
+// LimitBody wraps a response body decoded straight from the connection, reading from it fails
+// with ErrResponseTooLarge as soon as more than limit bytes are sent.
+func LimitBody(body io.ReadCloser, contentLength int64, limit int64) (io.ReadCloser, error) {
+	err := checkContentLength(contentLength, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedBody{
+		ReadCloser: body,
+		remaining:  limit,
+		limit:      limit,
+	}, nil
+}
+
+func checkContentLength(contentLength int64, limit int64) error {
+	if contentLength > limit {
+		return fmt.Errorf("%w: content length of %d bytes exceeds %d bytes", ErrResponseTooLarge, contentLength, limit)
+	}
+	return nil
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, fmt.Errorf("%w: body exceeds %d bytes", ErrResponseTooLarge, b.limit)
+	}
+	// read one byte past the limit to tell a body of exactly limit bytes from a larger one
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		// the byte past the limit is not handed out, a decoder could otherwise complete a value with it
+		return n - 1, fmt.Errorf("%w: body exceeds %d bytes", ErrResponseTooLarge, b.limit)
+	}
+	return n, err
+}
+
 type Response struct {
 	StatusCode int
 	Data       []byte
+
+	// Body is the unread body of a successful response decoded straight from the connection instead of being
+	// buffered in Data. ResponseObject or ResponseList reads and closes it, so such a response decodes only once.
+	Body io.ReadCloser
+
+	// Header holds the response headers, nil when the response did not come from the API
+	Header http.Header
+
+	// URL the response came from once redirects were followed, with secrets redacted. Empty when the response
+	// did not come from the API.
+	URL string
+
+	// StrictNotFound makes ResponseObject return ErrNotFound instead of a nil object when the resource does not exist
+	StrictNotFound bool
+
+	// Cached tells if the response was served from a cache instead of the API
+	Cached bool
+
+	// OnDecode is called with the error, if any, once ResponseObject or ResponseList decoded a successful response
+	OnDecode func(err error)
+
+	memo *decodeMemo
+}
+
+// decodeMemo holds decoded values shared between copies of a response, keyed by the decoded type
+type decodeMemo struct {
+	mu     sync.Mutex
+	values map[reflect.Type]decodeResult
+}
+
+type decodeResult struct {
+	value any
+	err   error
+}
+
+// Share returns a copy of the response for another caller, every copy decodes the body only once
+// for a given type and gets back the same decoded value, which callers must treat as read only
+func (resp *Response) Share() *Response {
+	if resp.memo == nil {
+		resp.memo = &decodeMemo{
+			values: map[reflect.Type]decodeResult{},
+		}
+	}
+	return &Response{
+		StatusCode:     resp.StatusCode,
+		Data:           resp.Data,
+		Header:         resp.Header,
+		URL:            resp.URL,
+		StrictNotFound: resp.StrictNotFound,
+		Cached:         resp.Cached,
+		memo:           resp.memo,
+	}
+}
+
+// decode runs fn once per decoded type across every shared copy of the response
+func decode[T any](resp *Response, fn func() (T, error)) (T, error) {
+	if resp.memo == nil {
+		return fn()
+	}
+	resp.memo.mu.Lock()
+	defer resp.memo.mu.Unlock()
+	key := reflect.TypeFor[T]()
+	if result, ok := resp.memo.values[key]; ok {
+		return result.value.(T), result.err
+	}
+	value, err := fn()
+	resp.memo.values[key] = decodeResult{
+		value: value,
+		err:   err,
+	}
+	return value, err
 }
 
+func (resp *Response) decoded(err error) {
+	if resp.OnDecode == nil {
+		return
+	}
+	// a missing resource is a successful decode of the API's not found answer
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	resp.OnDecode(err)
+}
+
+// checkNotFoundResponse reports whether the body is an empty list or null without decoding it
 func checkNotFoundResponse(data []byte) bool {
-	l, err := unmarshalResponseList[any](data)
-	if err != nil {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return true
+	}
+	if len(data) < 2 || data[0] != '[' || data[len(data)-1] != ']' {
 		return false
 	}
-	return len(l) == 0
+	return len(bytes.TrimSpace(data[1:len(data)-1])) == 0
 }
 
-func unmarshalResponseObject[Obj any](data []byte) (*Obj, error) {
+// unmarshalResponseObject decodes the buffered body of a response in a single pass
+func unmarshalResponseObject[Obj any](data []byte, strict bool) (*Obj, error) {
 	// for some reason this api returns a 200 and empty list on some endpoints when the resource is not found
 	emptyResp := checkNotFoundResponse(data)
 	if emptyResp {
-		return nil, nil
+		return notFound[Obj](strict)
 	}
-	body := io.NopCloser(bytes.NewReader(data))
 	obj := new(Obj)
-	err := json.NewDecoder(body).Decode(&obj)
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(&obj)
 	if err != nil {
 		return nil, err
 	}
-	err = body.Close()
+	return obj, nil
+}
+
+// streamResponseObject decodes a body as it is read from the connection
+func streamResponseObject[Obj any](body io.ReadCloser, strict bool) (*Obj, error) {
+	defer func() {
+		_ = body.Close()
+	}()
+	br := bufio.NewReader(body)
+	if emptyListAhead(br) {
+		return notFound[Obj](strict)
+	}
+	obj := new(Obj)
+	err := json.NewDecoder(br).Decode(&obj)
 	if err != nil {
 		return nil, err
 	}
+	// a null body leaves obj nil
+	if obj == nil {
+		return notFound[Obj](strict)
+	}
 	return obj, nil
 }
 
-func unmarshalResponseList[Obj any](data []byte) ([]Obj, error) {
-	body := io.NopCloser(bytes.NewReader(data))
+// emptyListAhead reports whether the body starts with an empty list, without consuming it
+func emptyListAhead(br *bufio.Reader) bool {
+	open := false
+	for i := 0; ; i++ {
+		ahead, err := br.Peek(i + 1)
+		if err != nil {
+			return false
+		}
+		switch b := ahead[i]; {
+		case b == ' ' || b == '\t' || b == '\r' || b == '\n':
+		case !open && b == '[':
+			open = true
+		default:
+			return open && b == ']'
+		}
+	}
+}
+
+func streamResponseList[Obj any](body io.ReadCloser) ([]Obj, error) {
+	defer func() {
+		_ = body.Close()
+	}()
 	objs := []Obj{}
 	err := json.NewDecoder(body).Decode(&objs)
 	if err != nil {
 		return nil, err
 	}
-	err = body.Close()
+	return objs, nil
+}
+
+func unmarshalResponseList[Obj any](data []byte) ([]Obj, error) {
+	objs := []Obj{}
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(&objs)
 	if err != nil {
 		return nil, err
 	}
 	return objs, nil
 }
 
+func notFound[Obj any](strict bool) (*Obj, error) {
+	if strict {
+		return nil, ErrNotFound
+	}
+	return nil, nil
+}
+
 // ResponseObject parses a http response and converts it to a generic object
 func ResponseObject[Obj any](resp *Response) (*Obj, error) {
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalResponseObject[Obj](resp.Data)
+		obj, err := decode(resp, func() (*Obj, error) {
+			if resp.Body != nil {
+				return streamResponseObject[Obj](resp.Body, resp.StrictNotFound)
+			}
+			return unmarshalResponseObject[Obj](resp.Data, resp.StrictNotFound)
+		})
+		resp.decoded(err)
+		return obj, err
 	case http.StatusNotFound:
-		return nil, nil
+		return notFound[Obj](resp.StrictNotFound)
 	default:
-		return nil, fmt.Errorf("error code %d returned: %s", resp.StatusCode, string(resp.Data))
+		return nil, NewAPIError(resp.StatusCode, resp.Data)
 	}
 }
 
@@ -70,8 +289,15 @@ func ResponseObject[Obj any](resp *Response) (*Obj, error) {
 func ResponseList[Obj any](resp *Response) ([]Obj, error) {
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalResponseList[Obj](resp.Data)
+		list, err := decode(resp, func() ([]Obj, error) {
+			if resp.Body != nil {
+				return streamResponseList[Obj](resp.Body)
+			}
+			return unmarshalResponseList[Obj](resp.Data)
+		})
+		resp.decoded(err)
+		return list, err
 	default:
-		return nil, fmt.Errorf("error code %d returned: %s", resp.StatusCode, string(resp.Data))
+		return nil, NewAPIError(resp.StatusCode, resp.Data)
 	}
 }
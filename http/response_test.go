@@ -1,8 +1,11 @@
 package http_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	raHttp "github.com/joshraphael/go-retroachievements/http"
@@ -260,3 +263,350 @@ func TestResponseList(tt *testing.T) {
 		})
 	}
 }
+
+func TestResponseObjectStrictNotFound(tt *testing.T) {
+	tests := []struct {
+		name   string
+		code   int
+		data   string
+		assert func(t *testing.T, obj *testObj, err error)
+	}{
+		{
+			name: "empty list",
+			code: http.StatusOK,
+			data: "[]",
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.Nil(t, obj)
+				require.ErrorIs(t, err, raHttp.ErrNotFound)
+			},
+		},
+		{
+			name: "not found status",
+			code: http.StatusNotFound,
+			data: `{"message":"not found"}`,
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.Nil(t, obj)
+				require.ErrorIs(t, err, raHttp.ErrNotFound)
+			},
+		},
+		{
+			name: "object",
+			code: http.StatusOK,
+			data: `{"id":"8710298370","name":"test"}`,
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObj{
+					ID:   "8710298370",
+					Name: "test",
+				}, obj)
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			r := &raHttp.Response{
+				StatusCode:     test.code,
+				Data:           []byte(test.data),
+				StrictNotFound: true,
+			}
+			obj, err := raHttp.ResponseObject[testObj](r)
+			test.assert(t, obj, err)
+		})
+	}
+}
+
+func TestResponseObjectNotFoundBodies(tt *testing.T) {
+	for _, body := range []string{"[]", " [ \n\t] \n", "null", " null "} {
+		tt.Run(body, func(t *testing.T) {
+			obj, err := raHttp.ResponseObject[testObj](&raHttp.Response{
+				StatusCode:     http.StatusOK,
+				Data:           []byte(body),
+				StrictNotFound: true,
+			})
+			require.Nil(t, obj)
+			require.ErrorIs(t, err, raHttp.ErrNotFound)
+		})
+		tt.Run(body+" streamed", func(t *testing.T) {
+			obj, err := raHttp.ResponseObject[testObj](&raHttp.Response{
+				StatusCode:     http.StatusOK,
+				Body:           io.NopCloser(strings.NewReader(body)),
+				StrictNotFound: true,
+			})
+			require.Nil(t, obj)
+			require.ErrorIs(t, err, raHttp.ErrNotFound)
+		})
+	}
+}
+
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestResponseStreamed(t *testing.T) {
+	body := &closeTracker{Reader: strings.NewReader(` {"id":"1","name":"test"}`)}
+	obj, err := raHttp.ResponseObject[testObj](&raHttp.Response{
+		StatusCode: http.StatusOK,
+		Body:       body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &testObj{ID: "1", Name: "test"}, obj)
+	require.True(t, body.closed)
+
+	body = &closeTracker{Reader: strings.NewReader(`[{"id":"1"},{"id":"2"}]`)}
+	list, err := raHttp.ResponseList[testObj](&raHttp.Response{
+		StatusCode: http.StatusOK,
+		Body:       body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []testObj{{ID: "1"}, {ID: "2"}}, list)
+	require.True(t, body.closed)
+
+	body = &closeTracker{Reader: strings.NewReader(`{"id":`)}
+	obj, err = raHttp.ResponseObject[testObj](&raHttp.Response{
+		StatusCode: http.StatusOK,
+		Body:       body,
+	})
+	require.Nil(t, obj)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.True(t, body.closed)
+}
+
+func TestReadBody(tt *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		limit         int64
+		assert        func(t *testing.T, data []byte, err error)
+	}{
+		{
+			name:          "known length",
+			body:          `{"id":"1"}`,
+			contentLength: 10,
+			limit:         10,
+			assert: func(t *testing.T, data []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `{"id":"1"}`, string(data))
+			},
+		},
+		{
+			name:          "unknown length",
+			body:          `{"id":"1"}`,
+			contentLength: -1,
+			limit:         100,
+			assert: func(t *testing.T, data []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `{"id":"1"}`, string(data))
+			},
+		},
+		{
+			name:          "content length too large",
+			body:          `{"id":"1"}`,
+			contentLength: 10,
+			limit:         5,
+			assert: func(t *testing.T, data []byte, err error) {
+				require.Nil(t, data)
+				require.ErrorIs(t, err, raHttp.ErrResponseTooLarge)
+				require.EqualError(t, err, "response too large: content length of 10 bytes exceeds 5 bytes")
+			},
+		},
+		{
+			name:          "body too large",
+			body:          `{"id":"1"}`,
+			contentLength: -1,
+			limit:         5,
+			assert: func(t *testing.T, data []byte, err error) {
+				require.Nil(t, data)
+				require.ErrorIs(t, err, raHttp.ErrResponseTooLarge)
+				require.EqualError(t, err, "response too large: body exceeds 5 bytes")
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			data, err := raHttp.ReadBody(strings.NewReader(test.body), test.contentLength, test.limit)
+			test.assert(t, data, err)
+		})
+	}
+}
+
+func TestLimitBody(tt *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		limit         int64
+		assert        func(t *testing.T, obj *testObj, err error)
+	}{
+		{
+			name:          "within limit",
+			body:          `{"id":"1"}`,
+			contentLength: -1,
+			limit:         10,
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObj{ID: "1"}, obj)
+			},
+		},
+		{
+			name:          "content length too large",
+			body:          `{"id":"1"}`,
+			contentLength: 10,
+			limit:         5,
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.Nil(t, obj)
+				require.ErrorIs(t, err, raHttp.ErrResponseTooLarge)
+				require.EqualError(t, err, "response too large: content length of 10 bytes exceeds 5 bytes")
+			},
+		},
+		{
+			name:          "body too large",
+			body:          `{"id":"1"}`,
+			contentLength: -1,
+			limit:         9,
+			assert: func(t *testing.T, obj *testObj, err error) {
+				require.Nil(t, obj)
+				require.ErrorIs(t, err, raHttp.ErrResponseTooLarge)
+				require.EqualError(t, err, "response too large: body exceeds 9 bytes")
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			body, err := raHttp.LimitBody(io.NopCloser(strings.NewReader(test.body)), test.contentLength, test.limit)
+			if err != nil {
+				test.assert(t, nil, err)
+				return
+			}
+			obj, err := raHttp.ResponseObject[testObj](&raHttp.Response{
+				StatusCode: http.StatusOK,
+				Body:       body,
+			})
+			test.assert(t, obj, err)
+		})
+	}
+}
+
+type benchObj struct {
+	Count   int        `json:"Count"`
+	Total   int        `json:"Total"`
+	Results []benchRow `json:"Results"`
+}
+
+type benchRow struct {
+	GameID       int    `json:"GameID"`
+	Title        string `json:"Title"`
+	ImageIcon    string `json:"ImageIcon"`
+	ConsoleName  string `json:"ConsoleName"`
+	NumAwarded   int    `json:"NumAwarded"`
+	HighestAward string `json:"HighestAwardKind"`
+}
+
+func benchBody(b *testing.B) []byte {
+	obj := benchObj{}
+	for i := range 5000 {
+		obj.Results = append(obj.Results, benchRow{
+			GameID:       i,
+			Title:        "Super Mario World",
+			ImageIcon:    "/Images/112443.png",
+			ConsoleName:  "SNES/Super Famicom",
+			NumAwarded:   96,
+			HighestAward: "mastered",
+		})
+	}
+	obj.Count = len(obj.Results)
+	obj.Total = len(obj.Results)
+	data, err := json.Marshal(obj)
+	require.NoError(b, err)
+	return data
+}
+
+// legacyResponseObject is the previous decoding path, parsing the body as a generic list before decoding it again
+func legacyResponseObject[Obj any](data []byte) (*Obj, error) {
+	l := []any{}
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&l); err == nil && len(l) == 0 {
+		return nil, nil
+	}
+	obj := new(Obj)
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func BenchmarkResponseObject(b *testing.B) {
+	data := benchBody(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := raHttp.ResponseObject[benchObj](&raHttp.Response{
+			StatusCode: http.StatusOK,
+			Data:       data,
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkResponseObjectStreamed(b *testing.B) {
+	data := benchBody(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := raHttp.ResponseObject[benchObj](&raHttp.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(data)),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkResponseObjectLegacy(b *testing.B) {
+	data := benchBody(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := legacyResponseObject[benchObj](data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadBody(b *testing.B) {
+	data := benchBody(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := raHttp.ReadBody(bytes.NewReader(data), int64(len(data)), 64<<20)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadBodyLegacy(b *testing.B) {
+	data := benchBody(b)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := io.ReadAll(bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
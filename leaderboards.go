@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,7 +11,17 @@ import (
 )
 
 // GetGameLeaderboards gets a given games's list of leaderboards.
+//
+// GetGameLeaderboards uses context.Background internally; to specify the context, use GetGameLeaderboardsContext.
 func (c *Client) GetGameLeaderboards(params models.GetGameLeaderboardsParameters) (*models.GetGameLeaderboards, error) {
+	return c.GetGameLeaderboardsContext(context.Background(), params)
+}
+
+// GetGameLeaderboardsContext gets a given games's list of leaderboards.
+func (c *Client) GetGameLeaderboardsContext(ctx context.Context, params models.GetGameLeaderboardsParameters) (*models.GetGameLeaderboards, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -24,7 +35,7 @@ func (c *Client) GetGameLeaderboards(params models.GetGameLeaderboardsParameters
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -36,7 +47,17 @@ func (c *Client) GetGameLeaderboards(params models.GetGameLeaderboardsParameters
 }
 
 // GetLeaderboardEntries gets a given leaderboards's entries.
+//
+// GetLeaderboardEntries uses context.Background internally; to specify the context, use GetLeaderboardEntriesContext.
 func (c *Client) GetLeaderboardEntries(params models.GetLeaderboardEntriesParameters) (*models.GetLeaderboardEntries, error) {
+	return c.GetLeaderboardEntriesContext(context.Background(), params)
+}
+
+// GetLeaderboardEntriesContext gets a given leaderboards's entries.
+func (c *Client) GetLeaderboardEntriesContext(ctx context.Context, params models.GetLeaderboardEntriesParameters) (*models.GetLeaderboardEntries, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -50,7 +71,7 @@ func (c *Client) GetLeaderboardEntries(params models.GetLeaderboardEntriesParame
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -62,7 +83,17 @@ func (c *Client) GetLeaderboardEntries(params models.GetLeaderboardEntriesParame
 }
 
 // GetUserGameLeaderboards gets a user's list of leaderboards for a given game.
+//
+// GetUserGameLeaderboards uses context.Background internally; to specify the context, use GetUserGameLeaderboardsContext.
 func (c *Client) GetUserGameLeaderboards(params models.GetUserGameLeaderboardsParameters) (*models.GetUserGameLeaderboards, error) {
+	return c.GetUserGameLeaderboardsContext(context.Background(), params)
+}
+
+// GetUserGameLeaderboardsContext gets a user's list of leaderboards for a given game.
+func (c *Client) GetUserGameLeaderboardsContext(ctx context.Context, params models.GetUserGameLeaderboardsParameters) (*models.GetUserGameLeaderboards, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -77,7 +108,7 @@ func (c *Client) GetUserGameLeaderboards(params models.GetUserGameLeaderboardsPa
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
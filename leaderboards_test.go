@@ -41,7 +41,7 @@ func TestGetGameLeaderboards(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetGameLeaderboards, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameLeaderboards.php?c=10&i=14402&o=10&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameLeaderboards.php?c=10&i=14402&o=10&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -183,7 +183,7 @@ func TestGetLeaderboardEntries(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetLeaderboardEntries, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetLeaderboardEntries.php?c=10&i=14402&o=10&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetLeaderboardEntries.php?c=10&i=14402&o=10&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -319,7 +319,7 @@ func TestGetUserGameLeaderboards(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUserGameLeaderboards, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserGameLeaderboards.php?c=10&i=515&o=10&u=test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserGameLeaderboards.php?c=10&i=515&o=10&u=test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
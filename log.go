@@ -0,0 +1,83 @@
+package retroachievements
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// LogBody logs the response body of every request on a debug level record, truncated to limit bytes
+func LogBody(limit int) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.LogBodyLimit = limit
+	})
+}
+
+// logged wraps a handler to log when each request starts and finishes, as well as any failure to decode its response
+func (c *Client) logged(next Handler) Handler {
+	return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+		redacted := req.Redacted()
+		values := redacted.Values()
+		params := make([]any, 0, len(values))
+		for k, v := range values {
+			params = append(params, slog.String(k, strings.Join(v, ",")))
+		}
+		paramsAttr := slog.Group("params", params...)
+		c.Logger.LogAttrs(ctx, slog.LevelDebug, "request started",
+			slog.String("method", req.Method),
+			slog.String("path", req.Path),
+			paramsAttr,
+		)
+		start := time.Now()
+		resp, err := next(ctx, req)
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("path", req.Path),
+			paramsAttr,
+			slog.Duration("latency", time.Since(start)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", redactError(err, req).Error()))
+			c.Logger.LogAttrs(ctx, slog.LevelError, "request failed", attrs...)
+			return resp, err
+		}
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.Int("size", len(resp.Data)),
+			slog.Bool("cached", resp.Cached),
+		)
+		c.Logger.LogAttrs(ctx, slog.LevelInfo, "request finished", attrs...)
+		// the body goes on its own debug record so info handlers never see it, Connect login responses hold the session token
+		if c.LogBodyLimit > 0 && c.Logger.Enabled(ctx, slog.LevelDebug) && !raHttp.IsConnectLogin(req.Path, req.Values().Get("r")) {
+			body := resp.Data
+			if len(body) > c.LogBodyLimit {
+				body = body[:c.LogBodyLimit]
+			}
+			c.Logger.LogAttrs(ctx, slog.LevelDebug, "response body",
+				slog.String("method", req.Method),
+				slog.String("path", req.Path),
+				paramsAttr,
+				slog.String("body", string(body)),
+			)
+		}
+		onDecode := resp.OnDecode
+		resp.OnDecode = func(err error) {
+			if err != nil {
+				c.Logger.LogAttrs(ctx, slog.LevelError, "response decode failed",
+					slog.String("method", req.Method),
+					slog.String("path", req.Path),
+					paramsAttr,
+					slog.Int("status", resp.StatusCode),
+					slog.String("error", err.Error()),
+				)
+			}
+			if onDecode != nil {
+				onDecode(err)
+			}
+		}
+		return resp, nil
+	}
+}
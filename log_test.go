@@ -0,0 +1,198 @@
+package retroachievements_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	logs := []map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		delete(entry, "time")
+		logs = append(logs, entry)
+	}
+	return logs
+}
+
+func TestLogger(tt *testing.T) {
+	tests := []struct {
+		name    string
+		level   slog.Level
+		details []retroachievements.ClientDetail
+		body    string
+		assert  func(t *testing.T, logs []map[string]any)
+	}{
+		{
+			name:  "info level",
+			level: slog.LevelInfo,
+			body:  `{"Title":"Twisted Metal"}`,
+			assert: func(t *testing.T, logs []map[string]any) {
+				require.Len(t, logs, 1)
+				require.Equal(t, "request finished", logs[0]["msg"])
+				require.Equal(t, "INFO", logs[0]["level"])
+				require.Equal(t, "/API/API_GetGame.php", logs[0]["path"])
+				require.Equal(t, map[string]any{"i": "1", "y": "REDACTED"}, logs[0]["params"])
+				require.Equal(t, float64(http.StatusOK), logs[0]["status"])
+				require.Equal(t, float64(25), logs[0]["size"])
+				require.Equal(t, false, logs[0]["cached"])
+				require.Contains(t, logs[0], "latency")
+				require.NotContains(t, logs[0], "body")
+			},
+		},
+		{
+			name:  "debug level with body",
+			level: slog.LevelDebug,
+			details: []retroachievements.ClientDetail{
+				retroachievements.LogBody(10),
+			},
+			body: `{"Title":"Twisted Metal"}`,
+			assert: func(t *testing.T, logs []map[string]any) {
+				require.Len(t, logs, 3)
+				require.Equal(t, "request started", logs[0]["msg"])
+				require.Equal(t, "DEBUG", logs[0]["level"])
+				require.Equal(t, map[string]any{"i": "1", "y": "REDACTED"}, logs[0]["params"])
+				require.Equal(t, "request finished", logs[1]["msg"])
+				require.Equal(t, "INFO", logs[1]["level"])
+				require.NotContains(t, logs[1], "body")
+				require.Equal(t, "response body", logs[2]["msg"])
+				require.Equal(t, "DEBUG", logs[2]["level"])
+				require.Equal(t, "/API/API_GetGame.php", logs[2]["path"])
+				require.Equal(t, `{"Title":"`, logs[2]["body"])
+			},
+		},
+		{
+			name:  "decode error",
+			level: slog.LevelInfo,
+			body:  `{"Title":1}`,
+			assert: func(t *testing.T, logs []map[string]any) {
+				require.Len(t, logs, 2)
+				require.Equal(t, "request finished", logs[0]["msg"])
+				require.Equal(t, "response decode failed", logs[1]["msg"])
+				require.Equal(t, "ERROR", logs[1]["level"])
+				require.Contains(t, logs[1]["error"], "cannot unmarshal number")
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := w.Write([]byte(test.body))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+			buf := &bytes.Buffer{}
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      server.URL,
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+				Logger:    slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: test.level})),
+			}, test.details...)
+			_, _ = client.GetGame(models.GetGameParameters{GameID: 1})
+			require.NotContains(t, buf.String(), "some_secret")
+			test.assert(t, decodeLogs(t, buf))
+		})
+	}
+}
+
+func TestLoggerRequestFailed(t *testing.T) {
+	buf := &bytes.Buffer{}
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "",
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+		Logger:    slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+	_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.Error(t, err)
+	logs := decodeLogs(t, buf)
+	require.Len(t, logs, 1)
+	require.Equal(t, "request failed", logs[0]["msg"])
+	require.Equal(t, "Get \"/API/API_GetGame.php?i=1&y=REDACTED\": unsupported protocol scheme \"\"", logs[0]["error"])
+}
+
+func TestLoggerConnectLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"Success":true,"User":"jamiras","Token":"session_token"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	buf := &bytes.Buffer{}
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		Logger:    slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	}, retroachievements.LogBody(1000))
+	resp, err := client.Login(models.LoginParameters{Username: "jamiras", Password: "hunter2"})
+	require.NoError(t, err)
+	require.Equal(t, "session_token", resp.Token)
+	require.NotContains(t, buf.String(), "session_token")
+	require.NotContains(t, buf.String(), "hunter2")
+	for _, entry := range decodeLogs(t, buf) {
+		require.NotContains(t, entry, "body")
+	}
+}
+
+// teeHandler sends every record to each handler enabled for its level
+type teeHandler []slog.Handler
+
+func (h teeHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h teeHandler) Handle(ctx context.Context, record slog.Record) error {
+	for _, handler := range h {
+		if handler.Enabled(ctx, record.Level) {
+			if err := handler.Handle(ctx, record.Clone()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (h teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h
+}
+
+func (h teeHandler) WithGroup(name string) slog.Handler {
+	return h
+}
+
+func TestLoggerBodyLevel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	info, debug := &bytes.Buffer{}, &bytes.Buffer{}
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+		Logger: slog.New(teeHandler{
+			slog.NewJSONHandler(info, &slog.HandlerOptions{Level: slog.LevelInfo}),
+			slog.NewJSONHandler(debug, &slog.HandlerOptions{Level: slog.LevelDebug}),
+		}),
+	}, retroachievements.LogBody(100))
+	_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.NotContains(t, info.String(), "Twisted Metal")
+	require.Contains(t, debug.String(), "Twisted Metal")
+}
@@ -0,0 +1,116 @@
+package retroachievements
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// ResponseMetadata describes how the API answered a call, next to the typed result returned by the endpoint
+type ResponseMetadata struct {
+	// HTTP response code status, zero if no response was received
+	StatusCode int
+
+	// Response headers, nil when the response was served from the cache
+	Header http.Header
+
+	// Rate limit state reported by the API
+	RateLimit RateLimitInfo
+
+	// Time spent on the call, including retries and rate limiting
+	Latency time.Duration
+
+	// Final URL the response came from once redirects were followed, or the URL the request was sent to
+	// when no response came back or it was served from the cache, with secrets redacted
+	URL string
+
+	// Raw response body
+	Raw []byte
+
+	// Whether the response was served from the cache
+	Cached bool
+
+	// Number of times the request was sent, zero when it was served from the cache
+	Attempts int
+}
+
+// RateLimitInfo holds the rate limit headers sent by the API, fields are zero when a header is missing
+type RateLimitInfo struct {
+	// Number of requests allowed in the current window, from X-RateLimit-Limit
+	Limit int
+
+	// Number of requests left in the current window, from X-RateLimit-Remaining
+	Remaining int
+
+	// When the current window ends, from X-RateLimit-Reset
+	Reset time.Time
+
+	// How long to wait before sending another request, from Retry-After
+	RetryAfter time.Duration
+}
+
+type metadataKey struct{}
+
+// WithMetadata returns a context that makes a call fill metadata with how the API answered it
+func WithMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, metadataKey{}, metadata)
+}
+
+func metadataFrom(ctx context.Context) *ResponseMetadata {
+	md, _ := ctx.Value(metadataKey{}).(*ResponseMetadata)
+	return md
+}
+
+// ParseRateLimit reads the rate limit headers of a response
+func ParseRateLimit(header http.Header, now time.Time) RateLimitInfo {
+	info := RateLimitInfo{}
+	if header == nil {
+		return info
+	}
+	info.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	info.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+	}
+	info.RetryAfter, _ = retryAfter(header, now)
+	return info
+}
+
+// recordMetadata wraps a handler to fill the metadata requested through the context, if any
+func recordMetadata(next Handler) Handler {
+	return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+		md := metadataFrom(ctx)
+		if md == nil {
+			return next(ctx, req)
+		}
+		*md = ResponseMetadata{}
+		attempts, _ := ctx.Value(attemptsKey{}).(*int)
+		start := time.Now()
+		resp, err := next(WithAttempts(ctx, &md.Attempts), req)
+		md.fill(req, resp, time.Since(start))
+		if attempts != nil {
+			*attempts = md.Attempts
+		}
+		return resp, err
+	}
+}
+
+// fill records the outcome of a call
+func (md *ResponseMetadata) fill(r *raHttp.Request, resp *raHttp.Response, latency time.Duration) {
+	md.URL = r.Redacted().URL()
+	md.Latency = latency
+	if resp == nil {
+		return
+	}
+	if resp.URL != "" {
+		md.URL = resp.URL
+	}
+	md.StatusCode = resp.StatusCode
+	md.Header = resp.Header
+	md.RateLimit = ParseRateLimit(resp.Header, time.Now())
+	md.Raw = resp.Data
+	md.Cached = resp.Cached
+}
@@ -0,0 +1,100 @@
+package retroachievements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		w.Header().Set("X-RateLimit-Reset", "1709400423")
+		_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.ResponseCache(retroachievements.NewMemoryCache(10)))
+
+	md := retroachievements.ResponseMetadata{}
+	attempts := -1
+	ctx := retroachievements.WithMetadata(retroachievements.WithAttempts(context.Background(), &attempts), &md)
+	game, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Twisted Metal", game.Title)
+	require.Equal(t, http.StatusOK, md.StatusCode)
+	require.Equal(t, "60", md.Header.Get("X-RateLimit-Limit"))
+	require.Equal(t, retroachievements.RateLimitInfo{
+		Limit:     60,
+		Remaining: 59,
+		Reset:     time.Unix(1709400423, 0),
+	}, md.RateLimit)
+	require.Equal(t, server.URL+"/API/API_GetGame.php?i=1&y=REDACTED", md.URL)
+	require.Equal(t, []byte(`{"Title":"Twisted Metal"}`), md.Raw)
+	require.False(t, md.Cached)
+	require.Equal(t, 1, md.Attempts)
+	require.Equal(t, 1, attempts)
+	require.Positive(t, md.Latency)
+
+	_, err = client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.True(t, md.Cached)
+	require.Nil(t, md.Header)
+	require.Equal(t, 0, md.Attempts)
+	require.Equal(t, []byte(`{"Title":"Twisted Metal"}`), md.Raw)
+}
+
+func TestWithMetadataRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/API/API_GetGame.php" {
+			http.Redirect(w, r, "/v2/API_GetGame.php?"+r.URL.RawQuery, http.StatusFound)
+			return
+		}
+		_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	md := retroachievements.ResponseMetadata{}
+	game, err := client.GetGameContext(retroachievements.WithMetadata(context.Background(), &md), models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Twisted Metal", game.Title)
+	require.Equal(t, server.URL+"/v2/API_GetGame.php?i=1&y=REDACTED", md.URL)
+}
+
+func TestWithMetadataError(t *testing.T) {
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "",
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	md := retroachievements.ResponseMetadata{}
+	_, err := client.GetGameContext(retroachievements.WithMetadata(context.Background(), &md), models.GetGameParameters{GameID: 1})
+	require.Error(t, err)
+	require.Equal(t, 0, md.StatusCode)
+	require.Equal(t, "/API/API_GetGame.php?i=1&y=REDACTED", md.URL)
+	require.Equal(t, 1, md.Attempts)
+}
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Now()
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+	require.Equal(t, retroachievements.RateLimitInfo{RetryAfter: 30 * time.Second}, retroachievements.ParseRateLimit(header, now))
+	require.Equal(t, retroachievements.RateLimitInfo{}, retroachievements.ParseRateLimit(nil, now))
+}
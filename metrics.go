@@ -0,0 +1,229 @@
+package retroachievements
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// Error classes reported to metrics
+const (
+	ErrorClassUnauthorized = "unauthorized"
+	ErrorClassRateLimited  = "rate_limited"
+	ErrorClassValidation   = "validation"
+	ErrorClassServer       = "server"
+	ErrorClassClient       = "client"
+	ErrorClassNotFound     = "not_found"
+	ErrorClassCanceled     = "canceled"
+	ErrorClassTransport    = "transport"
+	ErrorClassDecode       = "decode"
+)
+
+// DefaultLatencyBuckets are the upper bounds in seconds of the latency histogram buckets
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// RequestMetric describes a single call made by the client
+type RequestMetric struct {
+	// Endpoint name, such as API_GetUserSummary or dorequest:codenotes2
+	Endpoint string
+
+	// Time spent waiting for the response, including retries and rate limiting
+	Latency time.Duration
+
+	// HTTP response code status, zero if no response was received
+	StatusCode int
+
+	// Size of the response body in bytes
+	Bytes int
+
+	// Whether the response was served from the cache
+	Cached bool
+
+	// Class of the failure, empty if the call succeeded
+	ErrorClass string
+}
+
+// Metrics receives measurements for every call made by the client, implementations must be safe for concurrent use
+type Metrics interface {
+	// ObserveRequest is called once the API answered a call or the call failed
+	ObserveRequest(metric RequestMetric)
+
+	// ObserveDecodeError is called when a successful response could not be decoded
+	ObserveDecodeError(endpoint string)
+}
+
+// ReportMetrics makes the client report every call to metrics
+func ReportMetrics(metrics Metrics) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.Metrics = metrics
+	})
+}
+
+// EndpointName names the endpoint a request is sent to, using the script name for web API calls
+// and the request kind for Connect calls
+func EndpointName(req *raHttp.Request) string {
+	name := strings.TrimSuffix(path.Base(req.Path), ".php")
+	if name == "dorequest" {
+		return name + ":" + req.Values().Get("r")
+	}
+	return name
+}
+
+// errorClass sorts a failed call into one of the metric error classes
+func errorClass(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassCanceled
+	case errors.Is(err, ErrUnauthorized):
+		return ErrorClassUnauthorized
+	case errors.Is(err, ErrRateLimited):
+		return ErrorClassRateLimited
+	case errors.Is(err, ErrValidation):
+		return ErrorClassValidation
+	case errors.Is(err, ErrServer):
+		return ErrorClassServer
+	case errors.Is(err, ErrNotFound):
+		return ErrorClassNotFound
+	case errors.As(err, new(*APIError)):
+		return ErrorClassClient
+	}
+	return ErrorClassTransport
+}
+
+// statusErrorClass sorts a response status into one of the metric error classes, empty for successful responses
+func statusErrorClass(statusCode int) string {
+	switch statusCode {
+	case http.StatusOK:
+		return ""
+	case http.StatusNotFound:
+		return ErrorClassNotFound
+	}
+	return errorClass(raHttp.NewAPIError(statusCode, nil))
+}
+
+// measured wraps a handler to report every call and decode failure to the client metrics
+func (c *Client) measured(next Handler) Handler {
+	return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+		endpoint := EndpointName(req)
+		start := time.Now()
+		resp, err := next(ctx, req)
+		metric := RequestMetric{
+			Endpoint: endpoint,
+			Latency:  time.Since(start),
+		}
+		if err != nil {
+			metric.ErrorClass = errorClass(err)
+			c.Metrics.ObserveRequest(metric)
+			return resp, err
+		}
+		metric.StatusCode = resp.StatusCode
+		metric.Bytes = len(resp.Data)
+		metric.Cached = resp.Cached
+		metric.ErrorClass = statusErrorClass(resp.StatusCode)
+		c.Metrics.ObserveRequest(metric)
+		onDecode := resp.OnDecode
+		resp.OnDecode = func(err error) {
+			if err != nil {
+				c.Metrics.ObserveDecodeError(endpoint)
+			}
+			if onDecode != nil {
+				onDecode(err)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// metricsRegistry aggregates calls per endpoint, it backs the ready made metrics implementations
+type metricsRegistry struct {
+	mu        sync.Mutex
+	buckets   []float64
+	endpoints map[string]*endpointStats
+}
+
+// endpointStats holds the aggregated measurements of a single endpoint
+type endpointStats struct {
+	Calls          int64            `json:"calls"`
+	Errors         map[string]int64 `json:"errors"`
+	Bytes          int64            `json:"bytes"`
+	CacheHits      int64            `json:"cache_hits"`
+	LatencySum     float64          `json:"latency_seconds_sum"`
+	LatencyBuckets []int64          `json:"latency_seconds_buckets"`
+}
+
+func newMetricsRegistry(buckets []float64) *metricsRegistry {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &metricsRegistry{
+		buckets:   buckets,
+		endpoints: map[string]*endpointStats{},
+	}
+}
+
+func (r *metricsRegistry) stats(endpoint string) *endpointStats {
+	stats, ok := r.endpoints[endpoint]
+	if !ok {
+		stats = &endpointStats{
+			Errors:         map[string]int64{},
+			LatencyBuckets: make([]int64, len(r.buckets)),
+		}
+		r.endpoints[endpoint] = stats
+	}
+	return stats
+}
+
+// ObserveRequest adds a call to the endpoint totals
+func (r *metricsRegistry) ObserveRequest(metric RequestMetric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.stats(metric.Endpoint)
+	stats.Calls++
+	stats.Bytes += int64(metric.Bytes)
+	if metric.Cached {
+		stats.CacheHits++
+	}
+	if metric.ErrorClass != "" {
+		stats.Errors[metric.ErrorClass]++
+	}
+	seconds := metric.Latency.Seconds()
+	stats.LatencySum += seconds
+	for i, bound := range r.buckets {
+		if seconds <= bound {
+			stats.LatencyBuckets[i]++
+		}
+	}
+}
+
+// ObserveDecodeError adds a decode failure to the endpoint totals
+func (r *metricsRegistry) ObserveDecodeError(endpoint string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats(endpoint).Errors[ErrorClassDecode]++
+}
+
+// snapshot returns a copy of the totals of every endpoint
+func (r *metricsRegistry) snapshot() map[string]endpointStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := make(map[string]endpointStats, len(r.endpoints))
+	for endpoint, stats := range r.endpoints {
+		cp := *stats
+		cp.Errors = make(map[string]int64, len(stats.Errors))
+		for class, count := range stats.Errors {
+			cp.Errors[class] = count
+		}
+		cp.LatencyBuckets = append([]int64(nil), stats.LatencyBuckets...)
+		snapshot[endpoint] = cp
+	}
+	return snapshot
+}
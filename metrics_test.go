@@ -0,0 +1,113 @@
+package retroachievements_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointName(t *testing.T) {
+	require.Equal(t, "API_GetUserSummary", retroachievements.EndpointName(raHttp.NewRequest("", raHttp.Path("/API/API_GetUserSummary.php"))))
+	require.Equal(t, "dorequest:codenotes2", retroachievements.EndpointName(raHttp.NewRequest("", raHttp.Path("/dorequest.php"), raHttp.R("codenotes2"))))
+}
+
+func newMetricsServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("i") {
+		case "1":
+			_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+			require.NoError(t, err)
+		case "2":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			_, err := w.Write([]byte(`{"Title":2}`))
+			require.NoError(t, err)
+		}
+	}))
+}
+
+func callMetricsServer(t *testing.T, server *httptest.Server, metrics retroachievements.Metrics) {
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.ReportMetrics(metrics), retroachievements.ResponseCache(retroachievements.NewMemoryCache(10)))
+	for _, id := range []int{1, 1, 2, 3} {
+		_, _ = client.GetGame(models.GetGameParameters{GameID: id})
+	}
+	_, err := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "",
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.ReportMetrics(metrics)).GetCodeNotes(models.GetCodeNotesParameters{GameID: 1})
+	require.Error(t, err)
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	server := newMetricsServer(t)
+	defer server.Close()
+	metrics := retroachievements.NewPrometheusMetrics([]float64{60, 1})
+	callMetricsServer(t, server, metrics)
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	lines := strings.Split(string(body), "\n")
+	for _, expected := range []string{
+		"# TYPE retroachievements_requests_total counter",
+		`retroachievements_requests_total{endpoint="API_GetGame"} 4`,
+		`retroachievements_requests_total{endpoint="dorequest:codenotes2"} 1`,
+		`retroachievements_request_errors_total{endpoint="API_GetGame",class="decode"} 1`,
+		`retroachievements_request_errors_total{endpoint="API_GetGame",class="rate_limited"} 1`,
+		`retroachievements_request_errors_total{endpoint="dorequest:codenotes2",class="transport"} 1`,
+		"# TYPE retroachievements_request_duration_seconds histogram",
+		`retroachievements_request_duration_seconds_bucket{endpoint="API_GetGame",le="1"} 4`,
+		`retroachievements_request_duration_seconds_bucket{endpoint="API_GetGame",le="60"} 4`,
+		`retroachievements_request_duration_seconds_bucket{endpoint="API_GetGame",le="+Inf"} 4`,
+		`retroachievements_request_duration_seconds_count{endpoint="API_GetGame"} 4`,
+		`retroachievements_response_bytes_total{endpoint="API_GetGame"} 61`,
+		`retroachievements_cache_hits_total{endpoint="API_GetGame"} 1`,
+		`retroachievements_cache_hits_total{endpoint="dorequest:codenotes2"} 0`,
+	} {
+		require.Contains(t, lines, expected)
+	}
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	server := newMetricsServer(t)
+	defer server.Close()
+	metrics := retroachievements.NewExpvarMetrics("retroachievements_test", nil)
+	callMetricsServer(t, server, metrics)
+
+	published := struct {
+		Bounds    []float64 `json:"latency_seconds_bounds"`
+		Endpoints map[string]struct {
+			Calls     int64            `json:"calls"`
+			Errors    map[string]int64 `json:"errors"`
+			Bytes     int64            `json:"bytes"`
+			CacheHits int64            `json:"cache_hits"`
+			Buckets   []int64          `json:"latency_seconds_buckets"`
+		} `json:"endpoints"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("retroachievements_test").String()), &published))
+	require.Equal(t, retroachievements.DefaultLatencyBuckets, published.Bounds)
+	game := published.Endpoints["API_GetGame"]
+	require.Equal(t, int64(4), game.Calls)
+	require.Equal(t, map[string]int64{"decode": 1, "rate_limited": 1}, game.Errors)
+	require.Equal(t, int64(61), game.Bytes)
+	require.Equal(t, int64(1), game.CacheHits)
+	require.Len(t, game.Buckets, len(retroachievements.DefaultLatencyBuckets))
+	require.Equal(t, int64(1), published.Endpoints["dorequest:codenotes2"].Calls)
+	require.Equal(t, map[string]int64{"transport": 1}, published.Endpoints["dorequest:codenotes2"].Errors)
+}
@@ -0,0 +1,30 @@
+package retroachievements
+
+import (
+	"context"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// Handler sends a request to the API and returns its response
+type Handler func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error)
+
+// Middleware wraps a handler to inspect or change requests before they are sent and responses once they come back
+type Middleware func(next Handler) Handler
+
+// Middlewares adds middlewares around every request made by the client, the first one given is the outermost
+func Middlewares(middlewares ...Middleware) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+	})
+}
+
+// SetHeader is a middleware adding a header to every request
+func SetHeader(key string, value string) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+			req.Headers[key] = value
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package retroachievements_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewares(t *testing.T) {
+	var header string
+	var username string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Request-ID")
+		username = r.URL.Query().Get("u")
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+	calls := []string{}
+	record := func(name string) retroachievements.Middleware {
+		return func(next retroachievements.Handler) retroachievements.Handler {
+			return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+				calls = append(calls, name+" "+req.Path+" "+req.Params["u"])
+				resp, err := next(ctx, req)
+				if resp != nil {
+					calls = append(calls, name+" "+http.StatusText(resp.StatusCode))
+				}
+				return resp, err
+			}
+		}
+	}
+	rename := func(next retroachievements.Handler) retroachievements.Handler {
+		return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+			req.Params["u"] = "MaxMilyin"
+			return next(ctx, req)
+		}
+	}
+	client := retroachievements.New(
+		retroachievements.ClientConfig{
+			Host:      server.URL,
+			UserAgent: "go-retroachievements/v0.0.0",
+			APISecret: "some_secret",
+		},
+		retroachievements.Middlewares(record("outer"), retroachievements.SetHeader("X-Request-ID", "1234")),
+		retroachievements.Middlewares(rename, record("inner")),
+	)
+	_, err := client.GetUserProfile(models.GetUserProfileParameters{
+		Username: "jamiras",
+	})
+	require.ErrorContains(t, err, "error code 418 returned")
+	require.Equal(t, "1234", header)
+	require.Equal(t, "MaxMilyin", username)
+	require.Equal(t, []string{
+		"outer /API/API_GetUserProfile.php jamiras",
+		"inner /API/API_GetUserProfile.php MaxMilyin",
+		"inner I'm a teapot",
+		"outer I'm a teapot",
+	}, calls)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	client := retroachievements.New(
+		retroachievements.ClientConfig{
+			Host:      "http://localhost:0",
+			UserAgent: "go-retroachievements/v0.0.0",
+			APISecret: "some_secret",
+		},
+		retroachievements.Middlewares(func(next retroachievements.Handler) retroachievements.Handler {
+			return func(ctx context.Context, req *raHttp.Request) (*raHttp.Response, error) {
+				if req.Path == "/API/API_GetGame.php" {
+					return &raHttp.Response{
+						StatusCode: http.StatusOK,
+						Data:       []byte(`{"Title":"Twisted Metal"}`),
+					}, nil
+				}
+				return nil, errors.New("blocked")
+			}
+		}),
+	)
+	game, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Twisted Metal", game.Title)
+	_, err = client.GetUserProfile(models.GetUserProfileParameters{Username: "jamiras"})
+	require.EqualError(t, err, "calling endpoint: blocked")
+}
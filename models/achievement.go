@@ -11,6 +11,18 @@ type GetAchievementUnlocksParameters struct {
 
 	// [Optional] The number of entries to skip (default: 0).
 	Offset *int
+
+	// [Optional] Only query hardcore unlocks (default: false)
+	Hardcore *bool
+}
+
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementUnlocksParameters) Validate() error {
+	v := validator{}
+	v.positive("AchievementID", p.AchievementID)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
 }
 
 type GetAchievementUnlocks struct {
@@ -34,6 +34,27 @@ type GetCommentsParameters struct {
 	Type   GetCommentsType
 	Count  *int
 	Offset *int
+
+	// [Optional] Return the newest comments first instead of the oldest (default: false)
+	NewestFirst *bool
+}
+
+// Validate checks the parameters against their documented constraints
+func (p GetCommentsParameters) Validate() error {
+	v := validator{}
+	switch t := p.Type.(type) {
+	case nil:
+		v.add("Type", "must be set")
+	case GetCommentsGame:
+		v.positive("Type.GameID", t.GameID)
+	case GetCommentsAchievement:
+		v.positive("Type.AchievementID", t.AchievementID)
+	case GetCommentsUser:
+		v.notEmpty("Type.Username", t.Username)
+	}
+	v.count("Count", p.Count, 0)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
 }
 
 type GetComments struct {
@@ -5,6 +5,13 @@ type GetCodeNotesParameters struct {
 	GameID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetCodeNotesParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetCodeNotes struct {
 	Success   bool                   `json:"Success"`
 	CodeNotes []GetCodeNotesCodeNote `json:"CodeNotes"`
@@ -15,3 +22,36 @@ type GetCodeNotesCodeNote struct {
 	Address string `json:"Address"`
 	Note    string `json:"Note"`
 }
+
+type LoginParameters struct {
+	// The username to log in as
+	Username string
+
+	// [Optional] The account password, required when Token is empty
+	Password string
+
+	// [Optional] A session token from an earlier login, used when Password is empty
+	Token string
+}
+
+// Validate checks the parameters against their documented constraints
+func (p LoginParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	if p.Password == "" && p.Token == "" {
+		v.add("Password", "must be set when Token is empty")
+	}
+	return v.err()
+}
+
+type Login struct {
+	Success       bool   `json:"Success"`
+	User          string `json:"User"`
+	AvatarUrl     string `json:"AvatarUrl"`
+	Token         string `json:"Token"`
+	Score         int    `json:"Score"`
+	SoftcoreScore int    `json:"SoftcoreScore"`
+	Messages      int    `json:"Messages"`
+	Permissions   int    `json:"Permissions"`
+	AccountType   string `json:"AccountType"`
+}
@@ -4,6 +4,11 @@ import "time"
 
 type GetAchievementOfTheWeekParameters struct{}
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementOfTheWeekParameters) Validate() error {
+	return nil
+}
+
 type GetAchievementOfTheWeek struct {
 	Achievement          GetAchievementOfTheWeekAchievement `json:"Achievement"`
 	Console              GetAchievementOfTheWeekConsole     `json:"Console"`
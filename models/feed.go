@@ -1,6 +1,15 @@
 package models
 
-import "time"
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type GetRecentGameAwardsParameters struct {
 	// [Optional] Starting date (YYYY-MM-DD) (default: now).
@@ -16,6 +25,14 @@ type GetRecentGameAwardsParameters struct {
 	IncludePartialAwards *GetRecentGameAwardsParametersPartialAwards
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetRecentGameAwardsParameters) Validate() error {
+	v := validator{}
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetRecentGameAwardsParametersPartialAwards struct {
 	// Include beaten softcore awards
 	BeatenSoftcore bool
@@ -47,6 +64,11 @@ type GetRecentGameAwardsResult struct {
 }
 type GetActiveClaimsParameters struct{}
 
+// Validate checks the parameters against their documented constraints
+func (p GetActiveClaimsParameters) Validate() error {
+	return nil
+}
+
 type GetActiveClaims struct {
 	ID          int      `json:"ID"`
 	User        string   `json:"User"`
@@ -83,18 +105,23 @@ func (d *GetClaimsParametersKindDropped) GetClaimsParametersKindID() int {
 	return 2
 }
 
-// NOTE: Expired claims returns a strange format different from the rest, disabling it for now as there is not many available
-// type GetClaimsParametersKindExpired struct{}
+// GetClaimsParametersKindExpired asks for expired claims, which the API returns in a different format decoded by ExpiredClaims
+type GetClaimsParametersKindExpired struct{}
 
-// func (e *GetClaimsParametersKindExpired) GetClaimsParametersKindID() int {
-// 	return 3
-// }
+func (e *GetClaimsParametersKindExpired) GetClaimsParametersKindID() int {
+	return 3
+}
 
 type GetClaimsParameters struct {
 	// [Optional] The desired claim kind: completed, dropped, or expired (default: completed).
 	Kind GetClaimsParametersKind
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetClaimsParameters) Validate() error {
+	return nil
+}
+
 type GetClaims struct {
 	ID          int      `json:"ID"`
 	User        string   `json:"User"`
@@ -115,8 +142,133 @@ type GetClaims struct {
 	MinutesLeft int      `json:"MinutesLeft"`
 }
 
+// ExpiredClaims decodes the expired claims response into GetClaims. Unlike the other claim kinds the list can come
+// as an object keyed by position, numbers can be quoted and dates can be RFC3339 timestamps.
+type ExpiredClaims []GetClaims
+
+func (e *ExpiredClaims) UnmarshalJSON(d []byte) error {
+	d = bytes.TrimSpace(d)
+	raw := []looseGetClaims{}
+	switch {
+	case bytes.Equal(d, []byte("null")):
+	case len(d) > 0 && d[0] == '{':
+		byKey := map[string]looseGetClaims{}
+		if err := json.Unmarshal(d, &byKey); err != nil {
+			return err
+		}
+		keys := make([]string, 0, len(byKey))
+		for k := range byKey {
+			keys = append(keys, k)
+		}
+		slices.SortFunc(keys, comparePositions)
+		for _, k := range keys {
+			raw = append(raw, byKey[k])
+		}
+	default:
+		if err := json.Unmarshal(d, &raw); err != nil {
+			return err
+		}
+	}
+	claims := make(ExpiredClaims, len(raw))
+	for i, c := range raw {
+		claims[i] = GetClaims{
+			ID:          int(c.ID),
+			User:        c.User,
+			GameID:      int(c.GameID),
+			GameTitle:   c.GameTitle,
+			GameIcon:    c.GameIcon,
+			ConsoleID:   int(c.ConsoleID),
+			ConsoleName: c.ConsoleName,
+			ClaimType:   int(c.ClaimType),
+			SetType:     int(c.SetType),
+			Status:      int(c.Status),
+			Extension:   int(c.Extension),
+			Special:     int(c.Special),
+			Created:     DateTime(c.Created),
+			DoneTime:    DateTime(c.DoneTime),
+			Updated:     DateTime(c.Updated),
+			UserIsJrDev: int(c.UserIsJrDev),
+			MinutesLeft: int(c.MinutesLeft),
+		}
+	}
+	*e = claims
+	return nil
+}
+
+// comparePositions orders object keys numerically, falling back to text order for keys that are not numbers
+func comparePositions(a string, b string) int {
+	ai, aErr := strconv.Atoi(a)
+	bi, bErr := strconv.Atoi(b)
+	if aErr != nil || bErr != nil {
+		return strings.Compare(a, b)
+	}
+	return cmp.Compare(ai, bi)
+}
+
+// looseGetClaims mirrors GetClaims with fields accepting the expired claims format
+type looseGetClaims struct {
+	ID          looseInt      `json:"ID"`
+	User        string        `json:"User"`
+	GameID      looseInt      `json:"GameID"`
+	GameTitle   string        `json:"GameTitle"`
+	GameIcon    string        `json:"GameIcon"`
+	ConsoleID   looseInt      `json:"ConsoleID"`
+	ConsoleName string        `json:"ConsoleName"`
+	ClaimType   looseInt      `json:"ClaimType"`
+	SetType     looseInt      `json:"SetType"`
+	Status      looseInt      `json:"Status"`
+	Extension   looseInt      `json:"Extension"`
+	Special     looseInt      `json:"Special"`
+	Created     looseDateTime `json:"Created"`
+	DoneTime    looseDateTime `json:"DoneTime"`
+	Updated     looseDateTime `json:"Updated"`
+	UserIsJrDev looseInt      `json:"UserIsJrDev"`
+	MinutesLeft looseInt      `json:"MinutesLeft"`
+}
+
+// looseInt is a number that can be quoted, empty or null
+type looseInt int
+
+func (l *looseInt) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*l = 0
+		return nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("parsing number %s: %w", b, err)
+	}
+	*l = looseInt(i)
+	return nil
+}
+
+// looseDateTime is a "2006-01-02 15:04:05" or RFC3339 timestamp that can be empty or null
+type looseDateTime DateTime
+
+func (l *looseDateTime) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*l = looseDateTime{}
+		return nil
+	}
+	for _, layout := range []string{time.DateTime, time.RFC3339Nano} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			*l = looseDateTime{t}
+			return nil
+		}
+	}
+	return fmt.Errorf("parsing time %s: unknown format", b)
+}
+
 type GetTopTenUsersParameters struct{}
 
+// Validate checks the parameters against their documented constraints
+func (p GetTopTenUsersParameters) Validate() error {
+	return nil
+}
+
 type GetTopTenUsers struct {
 	Username      string `json:"1"`
 	HarcordPoints int    `json:"2"`
@@ -0,0 +1,59 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiredClaimsUnmarshalJSON(tt *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		assert func(t *testing.T, claims models.ExpiredClaims, err error)
+	}{
+		{
+			name:  "null",
+			input: `null`,
+			assert: func(t *testing.T, claims models.ExpiredClaims, err error) {
+				require.NoError(t, err)
+				require.Empty(t, claims)
+			},
+		},
+		{
+			name:  "positions in numeric order",
+			input: `{"10":{"ID":"3"},"9":{"ID":2},"x":{"ID":4},"1":{"ID":1}}`,
+			assert: func(t *testing.T, claims models.ExpiredClaims, err error) {
+				require.NoError(t, err)
+				ids := []int{}
+				for _, c := range claims {
+					ids = append(ids, c.ID)
+				}
+				require.Equal(t, []int{1, 2, 3, 4}, ids)
+			},
+		},
+		{
+			name:  "bad number",
+			input: `[{"ID":"abc"}]`,
+			assert: func(t *testing.T, claims models.ExpiredClaims, err error) {
+				require.EqualError(t, err, "parsing number \"abc\": strconv.Atoi: parsing \"abc\": invalid syntax")
+			},
+		},
+		{
+			name:  "bad date",
+			input: `[{"Created":"yesterday"}]`,
+			assert: func(t *testing.T, claims models.ExpiredClaims, err error) {
+				require.EqualError(t, err, "parsing time \"yesterday\": unknown format")
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			claims := models.ExpiredClaims{}
+			err := json.Unmarshal([]byte(test.input), &claims)
+			test.assert(t, claims, err)
+		})
+	}
+}
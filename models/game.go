@@ -8,6 +8,13 @@ type GetGameParameters struct {
 	GameID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGame struct {
 	Title        string    `json:"Title"`
 	ConsoleID    int       `json:"ConsoleID"`
@@ -36,6 +43,13 @@ type GetGameExtentedParameters struct {
 	Unofficial *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameExtentedParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGameExtented struct {
 	Title                      string                             `json:"Title"`
 	ConsoleID                  int                                `json:"ConsoleID"`
@@ -97,6 +111,13 @@ type GetGameHashesParameters struct {
 	GameID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameHashesParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGameHashes struct {
 	Results []GetGameHashesResult `json:"Results"`
 }
@@ -113,6 +134,13 @@ type GetAchievementCountParameters struct {
 	GameID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementCountParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetAchievementCount struct {
 	GameID         int   `json:"GameID"`
 	AchievementIDs []int `json:"AchievementIDs"`
@@ -129,6 +157,13 @@ type GetAchievementDistributionParameters struct {
 	Unofficial *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementDistributionParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetAchievementDistribution map[string]int
 
 type GetGameRankAndScoreParameters struct {
@@ -139,9 +174,67 @@ type GetGameRankAndScoreParameters struct {
 	LatestMasters *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameRankAndScoreParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGameRankAndScore struct {
 	User            string   `json:"User"`
 	NumAchievements int      `json:"NumAchievements"`
 	TotalScore      int      `json:"TotalScore"`
 	LastAward       DateTime `json:"LastAward"`
 }
+
+type GetGameProgressionParameters struct {
+	// The target game ID
+	GameID int
+
+	// [Optional] Only use hardcore players to calculate the medians (default: false)
+	Hardcore *bool
+}
+
+// Validate checks the parameters against their documented constraints
+func (p GetGameProgressionParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
+// GetGameProgression describes how long players take to beat, complete and master a game, times are in seconds
+type GetGameProgression struct {
+	ID                            int                             `json:"ID"`
+	Title                         string                          `json:"Title"`
+	ConsoleID                     int                             `json:"ConsoleID"`
+	ConsoleName                   string                          `json:"ConsoleName"`
+	ImageIcon                     string                          `json:"ImageIcon"`
+	NumDistinctPlayers            int                             `json:"NumDistinctPlayers"`
+	TimesUsedInBeatMedian         int                             `json:"TimesUsedInBeatMedian"`
+	TimesUsedInHardcoreBeatMedian int                             `json:"TimesUsedInHardcoreBeatMedian"`
+	MedianTimeToBeat              *int                            `json:"MedianTimeToBeat"`
+	MedianTimeToBeatHardcore      *int                            `json:"MedianTimeToBeatHardcore"`
+	TimesUsedInCompletionMedian   int                             `json:"TimesUsedInCompletionMedian"`
+	TimesUsedInMasteryMedian      int                             `json:"TimesUsedInMasteryMedian"`
+	MedianTimeToComplete          *int                            `json:"MedianTimeToComplete"`
+	MedianTimeToMaster            *int                            `json:"MedianTimeToMaster"`
+	NumAchievements               int                             `json:"NumAchievements"`
+	Achievements                  []GetGameProgressionAchievement `json:"Achievements"`
+}
+
+type GetGameProgressionAchievement struct {
+	ID                              int     `json:"ID"`
+	Title                           string  `json:"Title"`
+	Description                     string  `json:"Description"`
+	Points                          int     `json:"Points"`
+	TrueRatio                       int     `json:"TrueRatio"`
+	Type                            *string `json:"Type"`
+	BadgeName                       string  `json:"BadgeName"`
+	NumAwarded                      int     `json:"NumAwarded"`
+	NumAwardedHardcore              int     `json:"NumAwardedHardcore"`
+	TimesUsedInUnlockMedian         int     `json:"TimesUsedInUnlockMedian"`
+	TimesUsedInHardcoreUnlockMedian int     `json:"TimesUsedInHardcoreUnlockMedian"`
+	MedianTimeToUnlock              *int    `json:"MedianTimeToUnlock"`
+	MedianTimeToUnlockHardcore      *int    `json:"MedianTimeToUnlockHardcore"`
+}
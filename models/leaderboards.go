@@ -11,6 +11,15 @@ type GetGameLeaderboardsParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameLeaderboardsParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetGameLeaderboards struct {
 	Count   int                         `json:"Count"`
 	Total   int                         `json:"Total"`
@@ -43,6 +52,15 @@ type GetLeaderboardEntriesParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetLeaderboardEntriesParameters) Validate() error {
+	v := validator{}
+	v.positive("LeaderboardID", p.LeaderboardID)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetLeaderboardEntries struct {
 	Count   int                           `json:"Count"`
 	Total   int                           `json:"Total"`
@@ -71,6 +89,16 @@ type GetUserGameLeaderboardsParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserGameLeaderboardsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.positive("GameID", p.GameID)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetUserGameLeaderboards struct {
 	Count   int                             `json:"Count"`
 	Total   int                             `json:"Total"`
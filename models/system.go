@@ -8,6 +8,11 @@ type GetConsoleIDsParameters struct {
 	OnlyGameSystems *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetConsoleIDsParameters) Validate() error {
+	return nil
+}
+
 type GetConsoleIDs struct {
 	ID           int    `json:"ID"`
 	Name         string `json:"Name"`
@@ -33,6 +38,15 @@ type GetGameListParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameListParameters) Validate() error {
+	v := validator{}
+	v.positive("SystemID", p.SystemID)
+	v.count("Count", p.Count, 0)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetGameList struct {
 	Title           string    `json:"Title"`
 	ID              int       `json:"ID"`
@@ -5,6 +5,13 @@ type GetTicketByIDParameters struct {
 	TicketID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetTicketByIDParameters) Validate() error {
+	v := validator{}
+	v.positive("TicketID", p.TicketID)
+	return v.err()
+}
+
 type GetTicketByID struct {
 	ID                     int       `json:"ID"`
 	AchievementID          int       `json:"AchievementID"`
@@ -39,6 +46,14 @@ type GetMostTicketedGamesParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetMostTicketedGamesParameters) Validate() error {
+	v := validator{}
+	v.count("Count", p.Count, 100)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetMostTicketedGames struct {
 	MostReportedGames []GetMostTicketedGamesMostReportedGame `json:"MostReportedGames"`
 	URL               string                                 `json:"URL"`
@@ -60,6 +75,14 @@ type GetMostRecentTicketsParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetMostRecentTicketsParameters) Validate() error {
+	v := validator{}
+	v.count("Count", p.Count, 100)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetMostRecentTickets struct {
 	OpenTickets   int                                `json:"OpenTickets"`
 	URL           string                             `json:"URL"`
@@ -102,6 +125,13 @@ type GetGameTicketStatsParameters struct {
 	IncludeTicketMetadata *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameTicketStatsParameters) Validate() error {
+	v := validator{}
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGameTicketStats struct {
 	GameID      int                        `json:"GameID"`
 	GameTitle   string                     `json:"GameTitle"`
@@ -141,6 +171,13 @@ type GetDeveloperTicketStatsParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetDeveloperTicketStatsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetDeveloperTicketStats struct {
 	User     string `json:"User"`
 	Open     int    `json:"Open"`
@@ -155,6 +192,13 @@ type GetAchievementTicketStatsParameters struct {
 	AchievementID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementTicketStatsParameters) Validate() error {
+	v := validator{}
+	v.positive("AchievementID", p.AchievementID)
+	return v.err()
+}
+
 type GetAchievementTicketStats struct {
 	AchievementID          int     `json:"AchievementID"`
 	AchievementTitle       string  `json:"AchievementTitle"`
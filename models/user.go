@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -11,6 +12,13 @@ type GetUserProfileParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserProfileParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 // GetUserProfile describes elements of a users profile
 type GetUserProfile struct {
 	// Username of the profile
@@ -68,6 +76,14 @@ type GetUserRecentAchievementsParameters struct {
 	LookbackMinutes *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserRecentAchievementsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.count("LookbackMinutes", p.LookbackMinutes, 0)
+	return v.err()
+}
+
 // GetUserRecentAchievements describes elements of a users recent achievements
 type GetUserRecentAchievements struct {
 	// Title of the achievement
@@ -131,6 +147,22 @@ type GetAchievementsEarnedBetweenParameters struct {
 	To time.Time
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementsEarnedBetweenParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	if p.From.IsZero() {
+		v.add("From", "must be set")
+	}
+	if p.To.IsZero() {
+		v.add("To", "must be set")
+	}
+	if p.To.Before(p.From) {
+		v.add("To", "must not be before From")
+	}
+	return v.err()
+}
+
 // GetAchievementsEarnedBetween describes elements of an achievement earned between two dates
 type GetAchievementsEarnedBetween struct {
 	// Title of the achievement
@@ -191,6 +223,16 @@ type GetAchievementsEarnedOnDayParameters struct {
 	Date time.Time
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetAchievementsEarnedOnDayParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	if p.Date.IsZero() {
+		v.add("Date", "must be set")
+	}
+	return v.err()
+}
+
 // GetAchievementsEarnedOnDay describes elements of an achievement earned on a specific day
 type GetAchievementsEarnedOnDay struct {
 	// Title of the achievement
@@ -254,6 +296,14 @@ type GetGameInfoAndUserProgressParameters struct {
 	IncludeAwardMetadata *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetGameInfoAndUserProgressParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetGameInfoAndUserProgressAchievement struct {
 	ID                 int       `json:"ID"`
 	NumAwarded         int       `json:"NumAwarded"`
@@ -311,6 +361,21 @@ type GetGameInfoAndUserProgress struct {
 type GetUserCompletionProgressParameters struct {
 	// The target username
 	Username string
+
+	// [Optional] The number of records to return (default: 100, max: 500).
+	Count *int
+
+	// [Optional] The number of entries to skip (default: 0).
+	Offset *int
+}
+
+// Validate checks the parameters against their documented constraints
+func (p GetUserCompletionProgressParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
 }
 
 // GetUserCompletionProgress
@@ -340,6 +405,13 @@ type GetUserAwardsParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserAwardsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetUserAwards struct {
 	TotalAwardsCount          int     `json:"TotalAwardsCount"`
 	HiddenAwardsCount         int     `json:"HiddenAwardsCount"`
@@ -371,6 +443,13 @@ type GetUserClaimsParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserClaimsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetUserClaims struct {
 	ID          int      `json:"ID"`
 	User        string   `json:"User"`
@@ -400,6 +479,14 @@ type GetUserGameRankAndScoreParameters struct {
 	GameID int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserGameRankAndScoreParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.positive("GameID", p.GameID)
+	return v.err()
+}
+
 type GetUserGameRankAndScore struct {
 	User       string    `json:"User"`
 	UserRank   int       `json:"UserRank"`
@@ -413,6 +500,13 @@ type GetUserPointsParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserPointsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetUserPoints struct {
 	Points         int `json:"Points"`
 	SoftcorePoints int `json:"SoftcorePoints"`
@@ -427,6 +521,19 @@ type GetUserProgressParameters struct {
 	GameIDs []int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserProgressParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	if len(p.GameIDs) == 0 {
+		v.add("GameIDs", "must not be empty")
+	}
+	for i, id := range p.GameIDs {
+		v.positive(fmt.Sprintf("GameIDs[%d]", i), id)
+	}
+	return v.err()
+}
+
 type GetUserProgress struct {
 	NumPossibleAchievements int `json:"NumPossibleAchievements"`
 	PossibleScore           int `json:"PossibleScore"`
@@ -448,6 +555,15 @@ type GetUserRecentlyPlayedGamesParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserRecentlyPlayedGamesParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.count("Count", p.Count, 0)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetUserRecentlyPlayedGames struct {
 	NumPossibleAchievements int      `json:"NumPossibleAchievements"`
 	PossibleScore           int      `json:"PossibleScore"`
@@ -478,6 +594,15 @@ type GetUserSummaryParameters struct {
 	AchievementsCount *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserSummaryParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.count("GamesCount", p.GamesCount, 0)
+	v.count("AchievementsCount", p.AchievementsCount, 0)
+	return v.err()
+}
+
 type GetUserSummary struct {
 	User                string                           `json:"User"`
 	UserPic             string                           `json:"UserPic"`
@@ -600,6 +725,13 @@ type GetUserCompletedGamesParameters struct {
 	Username string
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserCompletedGamesParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetUserCompletedGames struct {
 	GameID       int    `json:"GameID"`
 	Title        string `json:"Title"`
@@ -623,6 +755,15 @@ type GetUserWantToPlayListParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserWantToPlayListParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetUserWantToPlayList struct {
 	Count   int                           `json:"Count"`
 	Total   int                           `json:"Total"`
@@ -647,6 +788,14 @@ type GetUsersIFollowParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUsersIFollowParameters) Validate() error {
+	v := validator{}
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetUsersIFollow struct {
 	Count   int                     `json:"Count"`
 	Total   int                     `json:"Total"`
@@ -668,6 +817,14 @@ type GetUsersFollowingMeParameters struct {
 	Offset *int
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUsersFollowingMeParameters) Validate() error {
+	v := validator{}
+	v.count("Count", p.Count, 500)
+	v.count("Offset", p.Offset, 0)
+	return v.err()
+}
+
 type GetUsersFollowingMe struct {
 	Count   int                         `json:"Count"`
 	Total   int                         `json:"Total"`
@@ -689,6 +846,13 @@ type GetUserSetRequestsParameters struct {
 	All *bool
 }
 
+// Validate checks the parameters against their documented constraints
+func (p GetUserSetRequestsParameters) Validate() error {
+	v := validator{}
+	v.notEmpty("Username", p.Username)
+	return v.err()
+}
+
 type GetUserSetRequests struct {
 	TotalRequests int                              `json:"TotalRequests"`
 	PointsForNext int                              `json:"PointsForNext"`
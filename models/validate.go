@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError describes why a single parameter is invalid
+type FieldError struct {
+	// Name of the parameter field, such as Count or Type.GameID
+	Field string
+
+	// Readable problem with the value
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// ValidationError is returned when parameters break their documented constraints, it lists every offending field
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		problems[i] = f.Error()
+	}
+	return "invalid parameters: " + strings.Join(problems, ", ")
+}
+
+// validator collects the field errors of a parameters struct
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field string, message string) {
+	v.fields = append(v.fields, FieldError{
+		Field:   field,
+		Message: message,
+	})
+}
+
+// notEmpty checks a required string such as a username
+func (v *validator) notEmpty(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "must not be empty")
+	}
+}
+
+// positive checks a required ID
+func (v *validator) positive(field string, value int) {
+	if value <= 0 {
+		v.add(field, "must be greater than 0")
+	}
+}
+
+// count checks an optional number of records or entries to skip, a zero max means no upper bound
+func (v *validator) count(field string, value *int, max int) {
+	switch {
+	case value == nil:
+	case *value < 0:
+		v.add(field, "must not be negative")
+	case max > 0 && *value > max:
+		v.add(field, fmt.Sprintf("must not be greater than %d", max))
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{
+		Fields: v.fields,
+	}
+}
@@ -0,0 +1,123 @@
+package models_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestValidate(tt *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{ Validate() error }
+		fields []models.FieldError
+	}{
+		{
+			name:   "no parameters",
+			params: models.GetConsoleIDsParameters{},
+		},
+		{
+			name: "valid",
+			params: models.GetAchievementUnlocksParameters{
+				AchievementID: 1,
+				Count:         ptr(500),
+				Offset:        ptr(0),
+			},
+		},
+		{
+			name: "every offending field",
+			params: models.GetUserGameLeaderboardsParameters{
+				Username: " ",
+				Count:    ptr(501),
+				Offset:   ptr(-1),
+			},
+			fields: []models.FieldError{
+				{Field: "Username", Message: "must not be empty"},
+				{Field: "GameID", Message: "must be greater than 0"},
+				{Field: "Count", Message: "must not be greater than 500"},
+				{Field: "Offset", Message: "must not be negative"},
+			},
+		},
+		{
+			name: "ticket count limit",
+			params: models.GetMostRecentTicketsParameters{
+				Count: ptr(101),
+			},
+			fields: []models.FieldError{
+				{Field: "Count", Message: "must not be greater than 100"},
+			},
+		},
+		{
+			name:   "comments without type",
+			params: models.GetCommentsParameters{},
+			fields: []models.FieldError{
+				{Field: "Type", Message: "must be set"},
+			},
+		},
+		{
+			name: "comments type",
+			params: models.GetCommentsParameters{
+				Type: models.GetCommentsUser{},
+			},
+			fields: []models.FieldError{
+				{Field: "Type.Username", Message: "must not be empty"},
+			},
+		},
+		{
+			name: "reversed time range",
+			params: models.GetAchievementsEarnedBetweenParameters{
+				Username: "Jamiras",
+				From:     time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+				To:       time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			fields: []models.FieldError{
+				{Field: "To", Message: "must not be before From"},
+			},
+		},
+		{
+			name: "missing date",
+			params: models.GetAchievementsEarnedOnDayParameters{
+				Username: "Jamiras",
+			},
+			fields: []models.FieldError{
+				{Field: "Date", Message: "must be set"},
+			},
+		},
+		{
+			name: "game ids",
+			params: models.GetUserProgressParameters{
+				Username: "Jamiras",
+				GameIDs:  []int{1, 0},
+			},
+			fields: []models.FieldError{
+				{Field: "GameIDs[1]", Message: "must be greater than 0"},
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			err := test.params.Validate()
+			if test.fields == nil {
+				require.NoError(t, err)
+				return
+			}
+			validationErr := &models.ValidationError{}
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, test.fields, validationErr.Fields)
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := models.GetLeaderboardEntriesParameters{
+		Count: ptr(-1),
+	}.Validate()
+	require.EqualError(t, err, "invalid parameters: LeaderboardID must be greater than 0, Count must not be negative")
+}
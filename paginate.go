@@ -0,0 +1,216 @@
+package retroachievements
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/joshraphael/go-retroachievements/models"
+)
+
+// DefaultCollectLimit is the largest number of results CollectAll gathers when no limit is given
+const DefaultCollectLimit = 10000
+
+// ErrTooManyResults is returned by CollectAll when a sequence yields more results than its limit
+var ErrTooManyResults = errors.New("too many results")
+
+// fetchPage fetches count results starting at offset, returning them along with the total number of results
+type fetchPage[T any] func(ctx context.Context, count int, offset int) ([]T, int, error)
+
+// paginate yields every result of a paginated endpoint, fetching pageSize results per call starting at offset.
+// It stops once the total is reached or a page comes back short, and yields the zero value with the error of
+// a failed call before stopping.
+func paginate[T any](ctx context.Context, pageSize int, offset *int, fetch fetchPage[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		start := 0
+		if offset != nil {
+			start = *offset
+		}
+		for {
+			results, total, err := fetch(ctx, pageSize, start)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, result := range results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			start += len(results)
+			if len(results) < pageSize || start >= total {
+				return
+			}
+		}
+	}
+}
+
+// clampPageSize returns size, or the endpoint maximum when size is not positive or larger than it
+func clampPageSize(size int, max int) int {
+	if size < 1 || size > max {
+		return max
+	}
+	return size
+}
+
+// CollectAll gathers the results of a sequence such as GetLeaderboardEntriesAll, stopping at the first error.
+// A sequence yielding more than limit results stops with ErrTooManyResults and the first limit results.
+// A limit below 1 uses DefaultCollectLimit.
+func CollectAll[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	if limit < 1 {
+		limit = DefaultCollectLimit
+	}
+	results := []T{}
+	for result, err := range seq {
+		if err != nil {
+			return results, err
+		}
+		if len(results) == limit {
+			return results, ErrTooManyResults
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// GetAchievementUnlocksAll iterates over every user who has earned an achievement, fetching pageSize users per call.
+// A pageSize below 1 fetches the endpoint maximum, params.Offset sets where to start and params.Count is ignored.
+func (c *Client) GetAchievementUnlocksAll(ctx context.Context, params models.GetAchievementUnlocksParameters, pageSize int) iter.Seq2[models.GetAchievementUnlocksUnlock, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetAchievementUnlocksUnlock, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetAchievementUnlocksContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Unlocks, resp.UnlocksCount, nil
+	})
+}
+
+// GetCommentsAll iterates over every comment of a game, achievement or user, fetching pageSize results per call.
+func (c *Client) GetCommentsAll(ctx context.Context, params models.GetCommentsParameters, pageSize int) iter.Seq2[models.GetCommentsResult, error] {
+	size := clampPageSize(pageSize, 100)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetCommentsResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetCommentsContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetRecentGameAwardsAll iterates over every recently granted game award, fetching pageSize results per call.
+func (c *Client) GetRecentGameAwardsAll(ctx context.Context, params models.GetRecentGameAwardsParameters, pageSize int) iter.Seq2[models.GetRecentGameAwardsResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetRecentGameAwardsResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetRecentGameAwardsContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetGameLeaderboardsAll iterates over every leaderboard of a game, fetching pageSize results per call.
+func (c *Client) GetGameLeaderboardsAll(ctx context.Context, params models.GetGameLeaderboardsParameters, pageSize int) iter.Seq2[models.GetGameLeaderboardsResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetGameLeaderboardsResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetGameLeaderboardsContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetLeaderboardEntriesAll iterates over every entry of a leaderboard, fetching pageSize results per call.
+func (c *Client) GetLeaderboardEntriesAll(ctx context.Context, params models.GetLeaderboardEntriesParameters, pageSize int) iter.Seq2[models.GetLeaderboardEntriesResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetLeaderboardEntriesResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetLeaderboardEntriesContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetUserGameLeaderboardsAll iterates over every leaderboard of a game a user has an entry on, fetching pageSize results per call.
+func (c *Client) GetUserGameLeaderboardsAll(ctx context.Context, params models.GetUserGameLeaderboardsParameters, pageSize int) iter.Seq2[models.GetUserGameLeaderboardsResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetUserGameLeaderboardsResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetUserGameLeaderboardsContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetUserWantToPlayListAll iterates over every game on a user's want to play list, fetching pageSize results per call.
+func (c *Client) GetUserWantToPlayListAll(ctx context.Context, params models.GetUserWantToPlayListParameters, pageSize int) iter.Seq2[models.GetUserWantToPlayListResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetUserWantToPlayListResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetUserWantToPlayListContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetUsersIFollowAll iterates over every user followed by the caller, fetching pageSize results per call.
+func (c *Client) GetUsersIFollowAll(ctx context.Context, params models.GetUsersIFollowParameters, pageSize int) iter.Seq2[models.GetUsersIFollowResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetUsersIFollowResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetUsersIFollowContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetUsersFollowingMeAll iterates over every user following the caller, fetching pageSize results per call.
+func (c *Client) GetUsersFollowingMeAll(ctx context.Context, params models.GetUsersFollowingMeParameters, pageSize int) iter.Seq2[models.GetUsersFollowingMeResult, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.GetUsersFollowingMeResult, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetUsersFollowingMeContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
+
+// GetUserCompletionProgressAll iterates over every game a user has played, fetching pageSize results per call.
+func (c *Client) GetUserCompletionProgressAll(ctx context.Context, params models.GetUserCompletionProgressParameters, pageSize int) iter.Seq2[models.CompletionProgress, error] {
+	size := clampPageSize(pageSize, 500)
+	return paginate(ctx, size, params.Offset, func(ctx context.Context, count int, offset int) ([]models.CompletionProgress, int, error) {
+		page := params
+		page.Count, page.Offset = &count, &offset
+		resp, err := c.GetUserCompletionProgressContext(ctx, page)
+		if err != nil || resp == nil {
+			return nil, 0, err
+		}
+		return resp.Results, resp.Total, nil
+	})
+}
@@ -0,0 +1,182 @@
+package retroachievements_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+// leaderboardServer serves a leaderboard with total entries, failing the call starting at failAt when it is positive
+func leaderboardServer(t *testing.T, total int, failAt int, calls *[]string) *retroachievements.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count, err := strconv.Atoi(r.URL.Query().Get("c"))
+		require.NoError(t, err)
+		offset, err := strconv.Atoi(r.URL.Query().Get("o"))
+		require.NoError(t, err)
+		*calls = append(*calls, fmt.Sprintf("c=%d&o=%d", count, offset))
+		if failAt > 0 && offset >= failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		resp := models.GetLeaderboardEntries{
+			Total:   total,
+			Results: []models.GetLeaderboardEntriesResult{},
+		}
+		for rank := offset + 1; rank <= min(offset+count, total); rank++ {
+			resp.Results = append(resp.Results, models.GetLeaderboardEntriesResult{Rank: rank})
+		}
+		resp.Count = len(resp.Results)
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+	return retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+}
+
+func ranks(entries []models.GetLeaderboardEntriesResult) []int {
+	result := []int{}
+	for _, entry := range entries {
+		result = append(result, entry.Rank)
+	}
+	return result
+}
+
+func TestPaginate(tt *testing.T) {
+	offset := 1
+	tests := []struct {
+		name     string
+		total    int
+		failAt   int
+		pageSize int
+		offset   *int
+		limit    int
+		calls    []string
+		ranks    []int
+		err      error
+	}{
+		{
+			name:     "all pages",
+			total:    5,
+			pageSize: 2,
+			calls:    []string{"c=2&o=0", "c=2&o=2", "c=2&o=4"},
+			ranks:    []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "exact pages",
+			total:    4,
+			pageSize: 2,
+			calls:    []string{"c=2&o=0", "c=2&o=2"},
+			ranks:    []int{1, 2, 3, 4},
+		},
+		{
+			name:     "starting offset",
+			total:    4,
+			pageSize: 2,
+			offset:   &offset,
+			calls:    []string{"c=2&o=1", "c=2&o=3"},
+			ranks:    []int{2, 3, 4},
+		},
+		{
+			name:  "endpoint maximum",
+			total: 3,
+			calls: []string{"c=500&o=0"},
+			ranks: []int{1, 2, 3},
+		},
+		{
+			name:     "empty",
+			total:    0,
+			pageSize: 2,
+			calls:    []string{"c=2&o=0"},
+			ranks:    []int{},
+		},
+		{
+			name:     "error",
+			total:    5,
+			failAt:   2,
+			pageSize: 2,
+			calls:    []string{"c=2&o=0", "c=2&o=2"},
+			ranks:    []int{1, 2},
+			err:      retroachievements.ErrServer,
+		},
+		{
+			name:     "limit",
+			total:    5,
+			pageSize: 2,
+			limit:    3,
+			calls:    []string{"c=2&o=0", "c=2&o=2"},
+			ranks:    []int{1, 2, 3},
+			err:      retroachievements.ErrTooManyResults,
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			calls := []string{}
+			client := leaderboardServer(t, test.total, test.failAt, &calls)
+			entries, err := retroachievements.CollectAll(client.GetLeaderboardEntriesAll(context.Background(), models.GetLeaderboardEntriesParameters{
+				LeaderboardID: 1,
+				Offset:        test.offset,
+			}, test.pageSize), test.limit)
+			require.ErrorIs(t, err, test.err)
+			require.Equal(t, test.ranks, ranks(entries))
+			require.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestPaginateBreak(t *testing.T) {
+	calls := []string{}
+	client := leaderboardServer(t, 10, 0, &calls)
+	seen := []int{}
+	for entry, err := range client.GetLeaderboardEntriesAll(context.Background(), models.GetLeaderboardEntriesParameters{
+		LeaderboardID: 1,
+	}, 2) {
+		require.NoError(t, err)
+		seen = append(seen, entry.Rank)
+		if entry.Rank == 3 {
+			break
+		}
+	}
+	require.Equal(t, []int{1, 2, 3}, seen)
+	require.Equal(t, []string{"c=2&o=0", "c=2&o=2"}, calls)
+}
+
+func TestGetAchievementUnlocksAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, err := strconv.Atoi(r.URL.Query().Get("o"))
+		require.NoError(t, err)
+		resp := models.GetAchievementUnlocks{
+			UnlocksCount: 3,
+			Unlocks:      []models.GetAchievementUnlocksUnlock{},
+		}
+		for i := offset; i < min(offset+2, 3); i++ {
+			resp.Unlocks = append(resp.Unlocks, models.GetAchievementUnlocksUnlock{User: fmt.Sprintf("user%d", i)})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	})
+	unlocks, err := retroachievements.CollectAll(client.GetAchievementUnlocksAll(context.Background(), models.GetAchievementUnlocksParameters{
+		AchievementID: 1,
+	}, 2), 0)
+	require.NoError(t, err)
+	users := []string{}
+	for _, unlock := range unlocks {
+		users = append(users, unlock.User)
+	}
+	require.Equal(t, []string{"user0", "user1", "user2"}, users)
+}
@@ -0,0 +1,99 @@
+package retroachievements
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PrometheusMetrics aggregates calls per endpoint and serves them in the Prometheus text exposition format
+type PrometheusMetrics struct {
+	registry *metricsRegistry
+}
+
+// NewPrometheusMetrics creates metrics using the latency histogram bucket bounds in seconds, or DefaultLatencyBuckets when none are given
+func NewPrometheusMetrics(buckets []float64) *PrometheusMetrics {
+	return &PrometheusMetrics{
+		registry: newMetricsRegistry(buckets),
+	}
+}
+
+// ObserveRequest adds a call to the endpoint totals
+func (m *PrometheusMetrics) ObserveRequest(metric RequestMetric) {
+	m.registry.ObserveRequest(metric)
+}
+
+// ObserveDecodeError adds a decode failure to the endpoint totals
+func (m *PrometheusMetrics) ObserveDecodeError(endpoint string) {
+	m.registry.ObserveDecodeError(endpoint)
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = w.Write(m.render())
+}
+
+func (m *PrometheusMetrics) render() []byte {
+	snapshot := m.registry.snapshot()
+	endpoints := make([]string, 0, len(snapshot))
+	for endpoint := range snapshot {
+		endpoints = append(endpoints, endpoint)
+	}
+	slices.Sort(endpoints)
+
+	buf := &bytes.Buffer{}
+	header := func(name string, kind string, help string) {
+		fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	header("retroachievements_requests_total", "counter", "Number of calls made to each endpoint.")
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(buf, "retroachievements_requests_total{endpoint=%s} %d\n", quote(endpoint), snapshot[endpoint].Calls)
+	}
+
+	header("retroachievements_request_errors_total", "counter", "Number of failed calls to each endpoint by error class.")
+	for _, endpoint := range endpoints {
+		classes := make([]string, 0, len(snapshot[endpoint].Errors))
+		for class := range snapshot[endpoint].Errors {
+			classes = append(classes, class)
+		}
+		slices.Sort(classes)
+		for _, class := range classes {
+			fmt.Fprintf(buf, "retroachievements_request_errors_total{endpoint=%s,class=%s} %d\n", quote(endpoint), quote(class), snapshot[endpoint].Errors[class])
+		}
+	}
+
+	header("retroachievements_request_duration_seconds", "histogram", "Latency of calls to each endpoint.")
+	for _, endpoint := range endpoints {
+		stats := snapshot[endpoint]
+		for i, bound := range m.registry.buckets {
+			fmt.Fprintf(buf, "retroachievements_request_duration_seconds_bucket{endpoint=%s,le=%s} %d\n", quote(endpoint), quote(strconv.FormatFloat(bound, 'g', -1, 64)), stats.LatencyBuckets[i])
+		}
+		fmt.Fprintf(buf, "retroachievements_request_duration_seconds_bucket{endpoint=%s,le=\"+Inf\"} %d\n", quote(endpoint), stats.Calls)
+		fmt.Fprintf(buf, "retroachievements_request_duration_seconds_sum{endpoint=%s} %s\n", quote(endpoint), strconv.FormatFloat(stats.LatencySum, 'g', -1, 64))
+		fmt.Fprintf(buf, "retroachievements_request_duration_seconds_count{endpoint=%s} %d\n", quote(endpoint), stats.Calls)
+	}
+
+	header("retroachievements_response_bytes_total", "counter", "Number of response body bytes received from each endpoint.")
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(buf, "retroachievements_response_bytes_total{endpoint=%s} %d\n", quote(endpoint), snapshot[endpoint].Bytes)
+	}
+
+	header("retroachievements_cache_hits_total", "counter", "Number of calls to each endpoint served from the cache.")
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(buf, "retroachievements_cache_hits_total{endpoint=%s} %d\n", quote(endpoint), snapshot[endpoint].CacheHits)
+	}
+	return buf.Bytes()
+}
+
+// quote escapes a label value as required by the text exposition format
+func quote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
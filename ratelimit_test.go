@@ -0,0 +1,65 @@
+package retroachievements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := retroachievements.NewRateLimiter(20, 2)
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestRateLimiterContextCanceled(t *testing.T) {
+	limiter := retroachievements.NewRateLimiter(0.01, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestRateLimitSharedBetweenClients(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, err := w.Write([]byte("[]"))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	limiter := retroachievements.NewRateLimiter(0.01, 2)
+	config := retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}
+	client1 := retroachievements.New(config, retroachievements.RateLimit(limiter))
+	client2 := retroachievements.New(config, retroachievements.RateLimit(limiter))
+
+	_, err := client1.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+	_, err = client2.GetGame(models.GetGameParameters{GameID: 1})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp, err := client1.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.EqualError(t, err, "calling endpoint: waiting for rate limiter: context deadline exceeded")
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,55 @@
+package retroachievements
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// redactedError hides secrets from the message of the error it wraps
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError hides the secrets sent with a request from an error, any URL error in the chain is
+// scrubbed in place so inspecting it with errors.As does not leak them either
+func redactError(err error, r *raHttp.Request) error {
+	return redactSecrets(err, r.Secrets()...)
+}
+
+// redactSecrets hides the given secrets from an error
+func redactSecrets(err error, secrets ...string) error {
+	if err == nil {
+		return nil
+	}
+	urlErr := &url.Error{}
+	if errors.As(err, &urlErr) {
+		urlErr.URL = raHttp.RedactURL(urlErr.URL)
+	}
+	msg := err.Error()
+	redacted := msg
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		redacted = strings.ReplaceAll(redacted, secret, raHttp.Redaction)
+	}
+	if redacted == msg {
+		return err
+	}
+	return &redactedError{
+		msg: redacted,
+		err: err,
+	}
+}
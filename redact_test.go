@@ -0,0 +1,34 @@
+package retroachievements_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactErrors(t *testing.T) {
+	client := retroachievements.New(
+		retroachievements.ClientConfig{
+			Host:      "http://localhost",
+			UserAgent: "go-retroachievements/v0.0.0",
+			APISecret: "some_secret",
+		},
+		retroachievements.HttpClient(&http.Client{
+			Transport: roundTripperFn(func(req *http.Request) (*http.Response, error) {
+				return nil, fmt.Errorf("proxy refused %s", req.URL.String())
+			}),
+		}),
+	)
+	_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.EqualError(t, err, "calling endpoint: Get \"http://localhost/API/API_GetGame.php?i=1&y=REDACTED\": proxy refused http://localhost/API/API_GetGame.php?i=1&y=REDACTED")
+	require.NotContains(t, err.Error(), "some_secret")
+	urlErr := &url.Error{}
+	require.True(t, errors.As(err, &urlErr))
+	require.Equal(t, "http://localhost/API/API_GetGame.php?i=1&y=REDACTED", urlErr.URL)
+}
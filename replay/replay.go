@@ -0,0 +1,366 @@
+// Package replay provides an HTTP transport that records API responses to fixture files and serves them back
+// without a network, so tests and examples can run offline and give the same results every time.
+//
+// Record once against the real API:
+//
+//	transport := replay.NewTransport("testdata/fixtures", replay.ModeRecord)
+//	client := retroachievements.New(config, retroachievements.HttpClient(&http.Client{Transport: transport}))
+//
+// then switch to replay.ModeReplay to serve the recorded fixtures.
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+)
+
+// ErrNoFixture is returned in replay mode when no fixture was recorded for a request
+var ErrNoFixture = errors.New("no fixture recorded for request")
+
+// Mode tells the transport whether to record or replay responses
+type Mode int
+
+const (
+	// ModeReplay serves responses from the fixture files and never uses the network
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and writes every response to a fixture file
+	ModeRecord
+)
+
+// Transport is an http.RoundTripper recording responses to fixture files or replaying them. Requests
+// are matched on method, path, query parameters and body, the host is ignored so fixtures recorded
+// against the real API can be replayed against any host.
+type Transport struct {
+	// Directory holding the fixture files
+	Dir string
+
+	// Whether to record or replay responses
+	Mode Mode
+
+	// Transport used to reach the API in record mode, http.DefaultTransport if nil
+	Next http.RoundTripper
+
+	// Usernames maps real usernames to the placeholders written to the fixtures instead. Query
+	// parameters matching a username are replaced in both modes, JSON string values in response
+	// bodies in record mode. Usernames match ignoring case.
+	Usernames map[string]string
+
+	mu sync.Mutex
+}
+
+// NewTransport creates a transport recording to or replaying from the fixtures in dir
+func NewTransport(dir string, mode Mode) *Transport {
+	return &Transport{
+		Dir:  dir,
+		Mode: mode,
+	}
+}
+
+// Fixture is a recorded request and its response as stored on disk
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest identifies a recorded request, secrets and usernames already scrubbed
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// FixtureResponse is the recorded answer of the API
+type FixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// RoundTrip records or replays the request depending on the transport mode
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	fr := FixtureRequest{
+		Method: req.Method,
+		URL:    t.scrubURL(req.URL),
+		Body:   string(t.scrubRequestBody(req, body)),
+	}
+	if t.Mode == ModeRecord {
+		return t.record(req, fr)
+	}
+	return t.replay(req, fr)
+}
+
+func (t *Transport) record(req *http.Request, fr FixtureRequest) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	data = t.scrubBody(data)
+	if raHttp.IsConnectLogin(req.URL.Path, connectKind(req, fr)) {
+		data = sessionToken.ReplaceAll(data, []byte(`"Token":"`+raHttp.Redaction+`"`))
+	}
+	header := resp.Header.Clone()
+	// scrubbing can change the body length and the date would make every recording differ
+	header.Del("Set-Cookie")
+	header.Del("Content-Length")
+	header.Del("Date")
+	fixture := Fixture{
+		Request: fr,
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(data),
+		},
+	}
+	err = t.write(fixture)
+	if err != nil {
+		return nil, err
+	}
+	return fixture.Response.httpResponse(req), nil
+}
+
+func (t *Transport) replay(req *http.Request, fr FixtureRequest) (*http.Response, error) {
+	data, err := os.ReadFile(filepath.Join(t.Dir, fixtureName(fr)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, t.mismatch(fr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture: %w", err)
+	}
+	fixture := Fixture{}
+	err = json.Unmarshal(data, &fixture)
+	if err != nil {
+		return nil, fmt.Errorf("parsing fixture: %w", err)
+	}
+	return fixture.Response.httpResponse(req), nil
+}
+
+// mismatch explains which request had no fixture and which requests were recorded for the same endpoint
+func (t *Transport) mismatch(fr FixtureRequest) error {
+	err := fmt.Errorf("%w: %s %s", ErrNoFixture, fr.Method, fr.URL)
+	files, _ := filepath.Glob(filepath.Join(t.Dir, endpointName(fr.URL)+"-*.json"))
+	recorded := []string{}
+	for _, file := range files {
+		data, readErr := os.ReadFile(file)
+		if readErr != nil {
+			continue
+		}
+		fixture := Fixture{}
+		if json.Unmarshal(data, &fixture) == nil {
+			recorded = append(recorded, fixture.Request.Method+" "+fixture.Request.URL)
+		}
+	}
+	if len(recorded) == 0 {
+		return fmt.Errorf("%w, nothing recorded for this endpoint in %s", err, t.Dir)
+	}
+	sort.Strings(recorded)
+	return fmt.Errorf("%w, recorded for this endpoint: %s", err, strings.Join(recorded, ", "))
+}
+
+func (t *Transport) write(fixture Fixture) error {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(fixture)
+	if err != nil {
+		return fmt.Errorf("encoding fixture: %w", err)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	err = os.MkdirAll(t.Dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("creating fixture directory: %w", err)
+	}
+	err = os.WriteFile(filepath.Join(t.Dir, fixtureName(fixture.Request)), buf.Bytes(), 0o644)
+	if err != nil {
+		return fmt.Errorf("writing fixture: %w", err)
+	}
+	return nil
+}
+
+// scrubURL returns the path and sorted query of a request, with the secrets redacted and usernames replaced
+func (t *Transport) scrubURL(u *url.URL) string {
+	q := u.Query()
+	for k, values := range q {
+		for i, v := range values {
+			if placeholder, ok := t.placeholder(v); ok {
+				values[i] = placeholder
+			}
+		}
+		q[k] = values
+	}
+	scrubbed := (&url.URL{Path: u.Path, RawQuery: q.Encode()}).String()
+	return raHttp.RedactURL(scrubbed)
+}
+
+// scrubRequestBody hides the Connect credentials of a URL encoded or multipart request body. Multipart bodies
+// are rewritten as a URL encoded form first, so their random boundary does not change the fixture name.
+func (t *Transport) scrubRequestBody(req *http.Request, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return body
+	}
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		return raHttp.RedactForm(req.URL.Path, body)
+	case "multipart/form-data":
+		form, err := multipartForm(body, params["boundary"])
+		if err != nil {
+			return body
+		}
+		return raHttp.RedactForm(req.URL.Path, []byte(form.Encode()))
+	default:
+		return body
+	}
+}
+
+// multipartForm turns a multipart body into form values, a file part is written as its file name and
+// the hash of its content
+func multipartForm(body []byte, boundary string) (url.Values, error) {
+	form := url.Values{}
+	r := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			return form, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading multipart body: %w", err)
+		}
+		data, err := io.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("reading part %s: %w", part.FormName(), err)
+		}
+		value := string(data)
+		if part.FileName() != "" {
+			sum := sha256.Sum256(data)
+			value = part.FileName() + ";sha256=" + hex.EncodeToString(sum[:])
+		}
+		form.Add(part.FormName(), value)
+	}
+}
+
+// sessionToken matches the session token of a Connect login response
+var sessionToken = regexp.MustCompile(`"Token"\s*:\s*"[^"]*"`)
+
+// connectKind returns the r parameter of a Connect request, sent either in the query or in the form body
+func connectKind(req *http.Request, fr FixtureRequest) string {
+	if kind := req.URL.Query().Get("r"); kind != "" {
+		return kind
+	}
+	form, err := url.ParseQuery(fr.Body)
+	if err != nil {
+		return ""
+	}
+	return form.Get("r")
+}
+
+// jsonString matches a JSON string literal, escaped quotes included
+var jsonString = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// scrubBody replaces the JSON string values matching a username, with the same case insensitive rule as
+// query parameters. Text merely containing a username, such as a game title, is left alone.
+func (t *Transport) scrubBody(data []byte) []byte {
+	if len(t.Usernames) == 0 {
+		return data
+	}
+	return jsonString.ReplaceAllFunc(data, func(literal []byte) []byte {
+		value := ""
+		if json.Unmarshal(literal, &value) != nil {
+			return literal
+		}
+		placeholder, ok := t.placeholder(value)
+		if !ok {
+			return literal
+		}
+		quoted, err := json.Marshal(placeholder)
+		if err != nil {
+			return literal
+		}
+		return quoted
+	})
+}
+
+// placeholder returns what replaces a value equal to one of the usernames, ignoring case
+func (t *Transport) placeholder(value string) (string, bool) {
+	for name, placeholder := range t.Usernames {
+		if strings.EqualFold(value, name) {
+			return placeholder, true
+		}
+	}
+	return "", false
+}
+
+func (fr FixtureResponse) httpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fr.StatusCode, http.StatusText(fr.StatusCode)),
+		StatusCode:    fr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        fr.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(fr.Body)),
+		ContentLength: int64(len(fr.Body)),
+		Request:       req,
+	}
+}
+
+// fixtureName names the fixture file after the endpoint and a hash of the scrubbed request
+func fixtureName(fr FixtureRequest) string {
+	sum := sha256.Sum256([]byte(fr.Method + " " + fr.URL + "\n" + fr.Body))
+	return endpointName(fr.URL) + "-" + hex.EncodeToString(sum[:6]) + ".json"
+}
+
+// endpointName uses the script name for web API calls and the request kind for Connect calls
+func endpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "request"
+	}
+	name := strings.TrimSuffix(path.Base(u.Path), ".php")
+	if name == "dorequest" {
+		return name + "-" + u.Query().Get("r")
+	}
+	return name
+}
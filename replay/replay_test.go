@@ -0,0 +1,230 @@
+package replay_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/joshraphael/go-retroachievements"
+	raHttp "github.com/joshraphael/go-retroachievements/http"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/joshraphael/go-retroachievements/replay"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(host string, transport http.RoundTripper) *retroachievements.Client {
+	return retroachievements.New(retroachievements.ClientConfig{
+		Host:      host,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.HttpClient(&http.Client{Transport: transport}))
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/API/API_GetUserProfile.php", r.URL.Path)
+		require.Equal(t, "Jamiras", r.URL.Query().Get("u"))
+		require.Equal(t, "some_secret", r.URL.Query().Get("y"))
+		w.Header().Set("Set-Cookie", "session=abc")
+		_, err := w.Write([]byte(`{"User":"Jamiras","TotalPoints":100}`))
+		require.NoError(t, err)
+	}))
+
+	recorder := replay.NewTransport(dir, replay.ModeRecord)
+	recorder.Usernames = map[string]string{
+		"Jamiras": "User1",
+	}
+	resp, err := newClient(server.URL, recorder).GetUserProfile(models.GetUserProfileParameters{
+		Username: "Jamiras",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "User1", resp.User)
+	require.Equal(t, 100, resp.TotalPoints)
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "API_GetUserProfile-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "some_secret")
+	require.NotContains(t, string(data), "Jamiras")
+	require.NotContains(t, string(data), "session=abc")
+	require.NotContains(t, string(data), "Content-Length")
+	require.Contains(t, string(data), `"url": "/API/API_GetUserProfile.php?u=User1&y=REDACTED"`)
+
+	player := replay.NewTransport(dir, replay.ModeReplay)
+	player.Usernames = recorder.Usernames
+	resp, err = newClient("http://unreachable.invalid", player).GetUserProfile(models.GetUserProfileParameters{
+		Username: "Jamiras",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "User1", resp.User)
+	require.Equal(t, 100, resp.TotalPoints)
+}
+
+func TestRecordUsernameCase(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"User":"Jamiras","RichPresenceMsg":"Playing Jamiras Quest","Motto":"\u004aamiras"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	recorder := replay.NewTransport(dir, replay.ModeRecord)
+	recorder.Usernames = map[string]string{
+		"jamiras": "User1",
+	}
+	resp, err := newClient(server.URL, recorder).GetUserProfile(models.GetUserProfileParameters{
+		Username: "JAMIRAS",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "User1", resp.User)
+	require.Equal(t, "User1", resp.Motto)
+	require.Equal(t, "Playing Jamiras Quest", resp.RichPresenceMsg)
+
+	files, err := filepath.Glob(filepath.Join(dir, "API_GetUserProfile-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "JAMIRAS")
+	require.Contains(t, string(data), "u=User1")
+	require.Contains(t, string(data), "Playing Jamiras Quest")
+}
+
+func TestReplayStatus(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := w.Write([]byte(`{"message":"Unauthenticated."}`))
+		require.NoError(t, err)
+	}))
+	_, err := newClient(server.URL, replay.NewTransport(dir, replay.ModeRecord)).GetGame(models.GetGameParameters{
+		GameID: 1,
+	})
+	require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+	server.Close()
+
+	_, err = newClient(server.URL, replay.NewTransport(dir, replay.ModeReplay)).GetGame(models.GetGameParameters{
+		GameID: 1,
+	})
+	require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+	require.EqualError(t, err, "parsing response object: error code 401 returned: {\"message\":\"Unauthenticated.\"}")
+}
+
+func TestReplayMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures map[string]string
+		err      string
+	}{
+		{
+			name: "nothing recorded",
+			err:  "calling endpoint: Get \"http://unreachable.invalid/API/API_GetGame.php?i=2&y=REDACTED\": no fixture recorded for request: GET /API/API_GetGame.php?i=2&y=REDACTED, nothing recorded for this endpoint in ",
+		},
+		{
+			name: "other requests recorded",
+			fixtures: map[string]string{
+				"API_GetGame-000000000000.json": `{"request":{"method":"GET","url":"/API/API_GetGame.php?i=1&y=REDACTED"},"response":{"status_code":200,"body":"{}"}}`,
+			},
+			err: "calling endpoint: Get \"http://unreachable.invalid/API/API_GetGame.php?i=2&y=REDACTED\": no fixture recorded for request: GET /API/API_GetGame.php?i=2&y=REDACTED, recorded for this endpoint: GET /API/API_GetGame.php?i=1&y=REDACTED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.fixtures {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+			}
+			resp, err := newClient("http://unreachable.invalid", replay.NewTransport(dir, replay.ModeReplay)).GetGame(models.GetGameParameters{
+				GameID: 2,
+			})
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, replay.ErrNoFixture)
+			expected := tt.err
+			if len(tt.fixtures) == 0 {
+				expected += dir
+			}
+			require.EqualError(t, err, expected)
+		})
+	}
+}
+
+func TestRecordConnectLogin(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "hunter2", r.PostForm.Get("p"))
+		_, err := w.Write([]byte(`{"Success":true,"User":"jamiras","Token":"session_token"}`))
+		require.NoError(t, err)
+	}))
+	_, err := newClient(server.URL, replay.NewTransport(dir, replay.ModeRecord)).Login(models.LoginParameters{
+		Username: "jamiras",
+		Password: "hunter2",
+	})
+	require.NoError(t, err)
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "dorequest-login2-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "hunter2")
+	require.NotContains(t, string(data), "session_token")
+	require.Contains(t, string(data), `"body": "p=REDACTED"`)
+
+	resp, err := newClient("http://unreachable.invalid", replay.NewTransport(dir, replay.ModeReplay)).Login(models.LoginParameters{
+		Username: "jamiras",
+		Password: "hunter2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "REDACTED", resp.Token)
+}
+
+func TestRecordReplayUpload(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		require.Equal(t, "secret_token", r.FormValue("t"))
+		_, err := w.Write([]byte(`{"Success":true}`))
+		require.NoError(t, err)
+	}))
+	type uploadResponse struct {
+		Success bool
+	}
+	upload := func(client *retroachievements.Client) (*uploadResponse, error) {
+		return retroachievements.Send[uploadResponse](context.Background(), client,
+			raHttp.Method(http.MethodPost),
+			raHttp.Path("/dorequest.php"),
+			raHttp.Param("r", "uploadbadgeimage"),
+			raHttp.FormValue("u", "jamiras"),
+			raHttp.FormValue("t", "secret_token"),
+			raHttp.FilePart(raHttp.Part{Field: "file", FileName: "badge.png", ContentType: "image/png", Data: []byte("png data")}),
+		)
+	}
+	resp, err := upload(newClient(server.URL, replay.NewTransport(dir, replay.ModeRecord)))
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "dorequest-uploadbadgeimage-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret_token")
+	require.NotContains(t, string(data), "png data")
+	require.Contains(t, string(data), "badge.png%3Bsha256%3D")
+	require.Contains(t, string(data), "t=REDACTED")
+
+	// a new upload uses another multipart boundary and must still match the fixture
+	resp, err = upload(newClient("http://unreachable.invalid", replay.NewTransport(dir, replay.ModeReplay)))
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+}
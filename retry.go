@@ -0,0 +1,133 @@
+package retroachievements
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes when and how often a failed request is sent again. Only GET and HEAD requests are
+// retried, requests sending a body could have taken effect before failing.
+type RetryPolicy struct {
+	// Maximum number of times a request is sent, including the first attempt
+	MaxAttempts int
+
+	// Backoff before the first retry, doubled on every following retry
+	BaseBackoff time.Duration
+
+	// Upper bound of the backoff between two attempts, zero leaves the backoff uncapped. A response asking
+	// through Retry-After for a longer wait is returned instead of retried.
+	MaxBackoff time.Duration
+
+	// Response status codes that are retried, transport errors are always retried
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy retries rate limited and server error responses up to 3 times
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// Retry makes the client send failed requests again according to the policy
+func Retry(policy RetryPolicy) ClientDetail {
+	return clientDetailFn(func(c *Client) {
+		c.RetryPolicy = &policy
+	})
+}
+
+type attemptsKey struct{}
+
+// WithAttempts returns a context that records how many attempts a call made into attempts
+func WithAttempts(ctx context.Context, attempts *int) context.Context {
+	return context.WithValue(ctx, attemptsKey{}, attempts)
+}
+
+func recordAttempts(ctx context.Context, attempts int) {
+	if a, ok := ctx.Value(attemptsKey{}).(*int); ok && a != nil {
+		*a = attempts
+	}
+}
+
+func (p *RetryPolicy) maxAttempts(method string) int {
+	if p == nil || p.MaxAttempts < 1 || !idempotent(method) {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// idempotent reports whether sending a request with the method twice has the same effect as sending it once,
+// an empty method is sent as GET
+func idempotent(method string) bool {
+	return method == "" || method == http.MethodGet || method == http.MethodHead
+}
+
+func (p *RetryPolicy) retryStatus(statusCode int) bool {
+	return slices.Contains(p.StatusCodes, statusCode)
+}
+
+// backoff returns how long to wait before the given retry, using exponential backoff with jitter
+// unless the server asked for a longer wait through the Retry-After header. It reports false when
+// that wait is over MaxBackoff, the request is not retried then.
+func (p *RetryPolicy) backoff(retry int, header http.Header) (time.Duration, bool) {
+	wait := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || wait < p.MaxBackoff) && wait <= math.MaxInt64/2; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait > 0 {
+		wait = wait/2 + rand.N(wait/2+1)
+	}
+	if after, ok := retryAfter(header, time.Now()); ok && after > wait {
+		if p.MaxBackoff > 0 && after > p.MaxBackoff {
+			return 0, false
+		}
+		wait = after
+	}
+	return wait, true
+}
+
+// retryAfter parses the Retry-After header, which holds either a number of seconds or a date
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, seconds >= 0
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now), true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
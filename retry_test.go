@@ -0,0 +1,194 @@
+package retroachievements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshraphael/go-retroachievements"
+	"github.com/joshraphael/go-retroachievements/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry(tt *testing.T) {
+	policy := retroachievements.RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		StatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+	tests := []struct {
+		name             string
+		failures         int
+		failureCode      int
+		retryAfter       string
+		maxBackoff       time.Duration
+		expectedAttempts int
+		assert           func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration)
+	}{
+		{
+			name:             "success first try",
+			failures:         0,
+			expectedAttempts: 1,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.NoError(t, err)
+				require.Equal(t, "Twisted Metal", resp.Title)
+			},
+		},
+		{
+			name:             "success after retries",
+			failures:         2,
+			failureCode:      http.StatusServiceUnavailable,
+			expectedAttempts: 3,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.NoError(t, err)
+				require.Equal(t, "Twisted Metal", resp.Title)
+			},
+		},
+		{
+			name:             "give up after max attempts",
+			failures:         3,
+			failureCode:      http.StatusServiceUnavailable,
+			expectedAttempts: 3,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrServer)
+			},
+		},
+		{
+			name:             "status not retried",
+			failures:         1,
+			failureCode:      http.StatusUnauthorized,
+			expectedAttempts: 1,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrUnauthorized)
+			},
+		},
+		{
+			name:             "honor retry after",
+			failures:         1,
+			failureCode:      http.StatusTooManyRequests,
+			retryAfter:       "1",
+			maxBackoff:       2 * time.Second,
+			expectedAttempts: 2,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, elapsed, time.Second)
+			},
+		},
+		{
+			name:             "retry after over max backoff",
+			failures:         1,
+			failureCode:      http.StatusTooManyRequests,
+			retryAfter:       "86400",
+			expectedAttempts: 1,
+			assert: func(t *testing.T, resp *models.GetGame, err error, elapsed time.Duration) {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, retroachievements.ErrRateLimited)
+				require.Less(t, elapsed, time.Second)
+			},
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			policy := policy
+			if test.maxBackoff > 0 {
+				policy.MaxBackoff = test.maxBackoff
+			}
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if int(calls.Add(1)) <= test.failures {
+					if test.retryAfter != "" {
+						w.Header().Set("Retry-After", test.retryAfter)
+					}
+					w.WriteHeader(test.failureCode)
+					return
+				}
+				_, err := w.Write([]byte(`{"Title":"Twisted Metal"}`))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+			client := retroachievements.New(retroachievements.ClientConfig{
+				Host:      server.URL,
+				UserAgent: "go-retroachievements/v0.0.0",
+				APISecret: "some_secret",
+			}, retroachievements.Retry(policy))
+			attempts := 0
+			ctx := retroachievements.WithAttempts(context.Background(), &attempts)
+			start := time.Now()
+			resp, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+			test.assert(t, resp, err, time.Since(start))
+			require.Equal(t, test.expectedAttempts, attempts)
+			require.Equal(t, int32(test.expectedAttempts), calls.Load())
+		})
+	}
+}
+
+func TestRetryTransportError(t *testing.T) {
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      "http://localhost",
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.Retry(retroachievements.RetryPolicy{
+		MaxAttempts: 2,
+		BaseBackoff: time.Millisecond,
+	}), retroachievements.HttpClient(&http.Client{
+		Transport: roundTripperFn(func(req *http.Request) (*http.Response, error) {
+			return nil, context.DeadlineExceeded
+		}),
+	}))
+	attempts := 0
+	ctx := retroachievements.WithAttempts(context.Background(), &attempts)
+	resp, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.Nil(t, resp)
+	require.ErrorContains(t, err, "calling endpoint: after 2 attempts: Get")
+	require.Equal(t, 2, attempts)
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.Retry(retroachievements.RetryPolicy{
+		MaxAttempts: 5,
+		BaseBackoff: time.Minute,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	resp, err := client.GetGameContext(ctx, models.GetGameParameters{GameID: 1})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.EqualError(t, err, "calling endpoint: waiting to retry after 1 attempts: context deadline exceeded")
+}
+
+func TestRetryBackoffUncapped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := retroachievements.New(retroachievements.ClientConfig{
+		Host:      server.URL,
+		UserAgent: "go-retroachievements/v0.0.0",
+		APISecret: "some_secret",
+	}, retroachievements.Retry(retroachievements.RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 20 * time.Millisecond,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	start := time.Now()
+	_, err := client.GetGame(models.GetGameParameters{GameID: 1})
+	require.ErrorIs(t, err, retroachievements.ErrServer)
+	// the backoffs double without a cap, waiting at least 10ms, 20ms and 40ms once jittered
+	// where a backoff stuck at the base would wait at most 60ms in total
+	require.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+}
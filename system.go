@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,7 +11,17 @@ import (
 )
 
 // GetConsoleIDs gets the complete list of all system ID and name pairs on the site.
+//
+// GetConsoleIDs uses context.Background internally; to specify the context, use GetConsoleIDsContext.
 func (c *Client) GetConsoleIDs(params models.GetConsoleIDsParameters) ([]models.GetConsoleIDs, error) {
+	return c.GetConsoleIDsContext(context.Background(), params)
+}
+
+// GetConsoleIDsContext gets the complete list of all system ID and name pairs on the site.
+func (c *Client) GetConsoleIDsContext(ctx context.Context, params models.GetConsoleIDsParameters) ([]models.GetConsoleIDs, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -31,7 +42,7 @@ func (c *Client) GetConsoleIDs(params models.GetConsoleIDsParameters) ([]models.
 		}
 		details = append(details, raHttp.G(g))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -43,7 +54,17 @@ func (c *Client) GetConsoleIDs(params models.GetConsoleIDsParameters) ([]models.
 }
 
 // GetGameList gets the complete list of games for a specified console on the site.
+//
+// GetGameList uses context.Background internally; to specify the context, use GetGameListContext.
 func (c *Client) GetGameList(params models.GetGameListParameters) ([]models.GetGameList, error) {
+	return c.GetGameListContext(context.Background(), params)
+}
+
+// GetGameListContext gets the complete list of games for a specified console on the site.
+func (c *Client) GetGameListContext(ctx context.Context, params models.GetGameListParameters) ([]models.GetGameList, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -71,7 +92,7 @@ func (c *Client) GetGameList(params models.GetGameListParameters) ([]models.GetG
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
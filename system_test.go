@@ -40,7 +40,7 @@ func TestGetConsoleIDs(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetConsoleIDs, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetConsoleIDs.php?a=1&g=1&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetConsoleIDs.php?a=1&g=1&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -182,7 +182,7 @@ func TestGetGameList(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetGameList, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameList.php?c=10&f=1&h=1&i=1&o=12&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameList.php?c=10&f=1&h=1&i=1&o=12&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,8 +11,19 @@ import (
 )
 
 // GetTicketByID gets ticket metadata information about a single achievement ticket, targeted by its ticket ID.
+//
+// GetTicketByID uses context.Background internally; to specify the context, use GetTicketByIDContext.
 func (c *Client) GetTicketByID(params models.GetTicketByIDParameters) (*models.GetTicketByID, error) {
+	return c.GetTicketByIDContext(context.Background(), params)
+}
+
+// GetTicketByIDContext gets ticket metadata information about a single achievement ticket, targeted by its ticket ID.
+func (c *Client) GetTicketByIDContext(ctx context.Context, params models.GetTicketByIDParameters) (*models.GetTicketByID, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetTicketData.php"),
@@ -31,7 +43,17 @@ func (c *Client) GetTicketByID(params models.GetTicketByIDParameters) (*models.G
 }
 
 // GetMostTicketedGames gets the games on the site with the highest count of opened achievement tickets.
+//
+// GetMostTicketedGames uses context.Background internally; to specify the context, use GetMostTicketedGamesContext.
 func (c *Client) GetMostTicketedGames(params models.GetMostTicketedGamesParameters) (*models.GetMostTicketedGames, error) {
+	return c.GetMostTicketedGamesContext(context.Background(), params)
+}
+
+// GetMostTicketedGamesContext gets the games on the site with the highest count of opened achievement tickets.
+func (c *Client) GetMostTicketedGamesContext(ctx context.Context, params models.GetMostTicketedGamesParameters) (*models.GetMostTicketedGames, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -45,7 +67,7 @@ func (c *Client) GetMostTicketedGames(params models.GetMostTicketedGamesParamete
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -57,7 +79,17 @@ func (c *Client) GetMostTicketedGames(params models.GetMostTicketedGamesParamete
 }
 
 // GetMostRecentTickets gets ticket metadata information about the latest opened achievement tickets on RetroAchievements.
+//
+// GetMostRecentTickets uses context.Background internally; to specify the context, use GetMostRecentTicketsContext.
 func (c *Client) GetMostRecentTickets(params models.GetMostRecentTicketsParameters) (*models.GetMostRecentTickets, error) {
+	return c.GetMostRecentTicketsContext(context.Background(), params)
+}
+
+// GetMostRecentTicketsContext gets ticket metadata information about the latest opened achievement tickets on RetroAchievements.
+func (c *Client) GetMostRecentTicketsContext(ctx context.Context, params models.GetMostRecentTicketsParameters) (*models.GetMostRecentTickets, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -70,7 +102,7 @@ func (c *Client) GetMostRecentTickets(params models.GetMostRecentTicketsParamete
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -82,7 +114,17 @@ func (c *Client) GetMostRecentTickets(params models.GetMostRecentTicketsParamete
 }
 
 // GetGameTicketStats gets ticket stats for a game, targeted by that game's unique ID.
+//
+// GetGameTicketStats uses context.Background internally; to specify the context, use GetGameTicketStatsContext.
 func (c *Client) GetGameTicketStats(params models.GetGameTicketStatsParameters) (*models.GetGameTicketStats, error) {
+	return c.GetGameTicketStatsContext(context.Background(), params)
+}
+
+// GetGameTicketStatsContext gets ticket stats for a game, targeted by that game's unique ID.
+func (c *Client) GetGameTicketStatsContext(ctx context.Context, params models.GetGameTicketStatsParameters) (*models.GetGameTicketStats, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -96,7 +138,7 @@ func (c *Client) GetGameTicketStats(params models.GetGameTicketStatsParameters)
 	if params.IncludeTicketMetadata != nil && *params.IncludeTicketMetadata {
 		details = append(details, raHttp.D(strconv.Itoa(1)))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -108,8 +150,19 @@ func (c *Client) GetGameTicketStats(params models.GetGameTicketStatsParameters)
 }
 
 // GetDeveloperTicketStats gets ticket stats for a developer, targeted by that developer's site username.
+//
+// GetDeveloperTicketStats uses context.Background internally; to specify the context, use GetDeveloperTicketStatsContext.
 func (c *Client) GetDeveloperTicketStats(params models.GetDeveloperTicketStatsParameters) (*models.GetDeveloperTicketStats, error) {
+	return c.GetDeveloperTicketStatsContext(context.Background(), params)
+}
+
+// GetDeveloperTicketStatsContext gets ticket stats for a developer, targeted by that developer's site username.
+func (c *Client) GetDeveloperTicketStatsContext(ctx context.Context, params models.GetDeveloperTicketStatsParameters) (*models.GetDeveloperTicketStats, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetTicketData.php"),
@@ -126,9 +179,20 @@ func (c *Client) GetDeveloperTicketStats(params models.GetDeveloperTicketStatsPa
 	return resp, nil
 }
 
-// GetDeveloperTicketStats gets ticket stats for an achievement, targeted by that achievement's unique ID.
+// GetAchievementTicketStats gets ticket stats for an achievement, targeted by that achievement's unique ID.
+//
+// GetAchievementTicketStats uses context.Background internally; to specify the context, use GetAchievementTicketStatsContext.
 func (c *Client) GetAchievementTicketStats(params models.GetAchievementTicketStatsParameters) (*models.GetAchievementTicketStats, error) {
+	return c.GetAchievementTicketStatsContext(context.Background(), params)
+}
+
+// GetAchievementTicketStatsContext gets ticket stats for an achievement, targeted by that achievement's unique ID.
+func (c *Client) GetAchievementTicketStatsContext(ctx context.Context, params models.GetAchievementTicketStatsParameters) (*models.GetAchievementTicketStats, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetTicketData.php"),
@@ -44,7 +44,7 @@ func TestGetTicketByID(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetTicketByID, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?i=1&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?i=1&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -199,7 +199,7 @@ func TestGetMostTicketedGames(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetMostTicketedGames, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?c=10&f=1&o=12&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?c=10&f=1&o=12&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -332,7 +332,7 @@ func TestGetMostRecentTickets(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetMostRecentTickets, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?c=10&o=12&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?c=10&o=12&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -508,7 +508,7 @@ func TestGetGameTicketStats(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetGameTicketStats, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?d=1&f=5&g=1&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?d=1&f=5&g=1&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -681,7 +681,7 @@ func TestGetDeveloperTicketStats(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetDeveloperTicketStats, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?u=jamiras&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?u=jamiras&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -798,7 +798,7 @@ func TestGetAchievementTicketStats(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetAchievementTicketStats, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?a=284759&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetTicketData.php?a=284759&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -889,3 +889,74 @@ func TestGetAchievementTicketStats(tt *testing.T) {
 		})
 	}
 }
+
+func TestTicketDataQuery(tt *testing.T) {
+	count, offset, unofficial, metadata := 10, 5, true, true
+	tests := []struct {
+		name     string
+		call     func(client *retroachievements.Client) error
+		expected string
+	}{
+		{
+			name: "ticket",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetTicketByID(models.GetTicketByIDParameters{TicketID: 1})
+				return err
+			},
+			expected: "i=1",
+		},
+		{
+			name: "most ticketed games",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetMostTicketedGames(models.GetMostTicketedGamesParameters{Count: &count, Offset: &offset})
+				return err
+			},
+			expected: "c=10&f=1&o=5",
+		},
+		{
+			name: "most recent tickets",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetMostRecentTickets(models.GetMostRecentTicketsParameters{Count: &count, Offset: &offset})
+				return err
+			},
+			expected: "c=10&o=5",
+		},
+		{
+			name: "official game tickets",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetGameTicketStats(models.GetGameTicketStatsParameters{GameID: 1})
+				return err
+			},
+			expected: "g=1",
+		},
+		{
+			name: "unofficial game tickets",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetGameTicketStats(models.GetGameTicketStatsParameters{GameID: 1, Unofficial: &unofficial, IncludeTicketMetadata: &metadata})
+				return err
+			},
+			expected: "d=1&f=5&g=1",
+		},
+		{
+			name: "developer tickets",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetDeveloperTicketStats(models.GetDeveloperTicketStatsParameters{Username: "jamiras"})
+				return err
+			},
+			expected: "u=jamiras",
+		},
+		{
+			name: "achievement tickets",
+			call: func(client *retroachievements.Client) error {
+				_, err := client.GetAchievementTicketStats(models.GetAchievementTicketStatsParameters{AchievementID: 2})
+				return err
+			},
+			expected: "a=2",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, capturedQuery(t, test.call))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package retroachievements
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,8 +12,19 @@ import (
 )
 
 // GetUserProfile get a user's basic profile information.
+//
+// GetUserProfile uses context.Background internally; to specify the context, use GetUserProfileContext.
 func (c *Client) GetUserProfile(params models.GetUserProfileParameters) (*models.GetUserProfile, error) {
+	return c.GetUserProfileContext(context.Background(), params)
+}
+
+// GetUserProfileContext get a user's basic profile information.
+func (c *Client) GetUserProfileContext(ctx context.Context, params models.GetUserProfileParameters) (*models.GetUserProfile, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserProfile.php"),
@@ -30,7 +42,17 @@ func (c *Client) GetUserProfile(params models.GetUserProfileParameters) (*models
 }
 
 // GetUserRecentAchievements get a list of achievements recently earned by the user.
+//
+// GetUserRecentAchievements uses context.Background internally; to specify the context, use GetUserRecentAchievementsContext.
 func (c *Client) GetUserRecentAchievements(params models.GetUserRecentAchievementsParameters) ([]models.GetUserRecentAchievements, error) {
+	return c.GetUserRecentAchievementsContext(context.Background(), params)
+}
+
+// GetUserRecentAchievementsContext get a list of achievements recently earned by the user.
+func (c *Client) GetUserRecentAchievementsContext(ctx context.Context, params models.GetUserRecentAchievementsParameters) ([]models.GetUserRecentAchievements, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -41,7 +63,7 @@ func (c *Client) GetUserRecentAchievements(params models.GetUserRecentAchievemen
 	if params.LookbackMinutes != nil {
 		details = append(details, raHttp.M(*params.LookbackMinutes))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -53,8 +75,19 @@ func (c *Client) GetUserRecentAchievements(params models.GetUserRecentAchievemen
 }
 
 // GetAchievementsEarnedBetween get a list of achievements earned by a user between two dates.
+//
+// GetAchievementsEarnedBetween uses context.Background internally; to specify the context, use GetAchievementsEarnedBetweenContext.
 func (c *Client) GetAchievementsEarnedBetween(params models.GetAchievementsEarnedBetweenParameters) ([]models.GetAchievementsEarnedBetween, error) {
+	return c.GetAchievementsEarnedBetweenContext(context.Background(), params)
+}
+
+// GetAchievementsEarnedBetweenContext get a list of achievements earned by a user between two dates.
+func (c *Client) GetAchievementsEarnedBetweenContext(ctx context.Context, params models.GetAchievementsEarnedBetweenParameters) ([]models.GetAchievementsEarnedBetween, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetAchievementsEarnedBetween.php"),
@@ -74,8 +107,19 @@ func (c *Client) GetAchievementsEarnedBetween(params models.GetAchievementsEarne
 }
 
 // GetAchievementsEarnedOnDay get a list of achievements earned by a user on a given date.
+//
+// GetAchievementsEarnedOnDay uses context.Background internally; to specify the context, use GetAchievementsEarnedOnDayContext.
 func (c *Client) GetAchievementsEarnedOnDay(params models.GetAchievementsEarnedOnDayParameters) ([]models.GetAchievementsEarnedOnDay, error) {
+	return c.GetAchievementsEarnedOnDayContext(context.Background(), params)
+}
+
+// GetAchievementsEarnedOnDayContext get a list of achievements earned by a user on a given date.
+func (c *Client) GetAchievementsEarnedOnDayContext(ctx context.Context, params models.GetAchievementsEarnedOnDayParameters) ([]models.GetAchievementsEarnedOnDay, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetAchievementsEarnedOnDay.php"),
@@ -94,7 +138,17 @@ func (c *Client) GetAchievementsEarnedOnDay(params models.GetAchievementsEarnedO
 }
 
 // GetGameInfoAndUserProgress get metadata about a game as well as a user's progress on that game.
+//
+// GetGameInfoAndUserProgress uses context.Background internally; to specify the context, use GetGameInfoAndUserProgressContext.
 func (c *Client) GetGameInfoAndUserProgress(params models.GetGameInfoAndUserProgressParameters) (*models.GetGameInfoAndUserProgress, error) {
+	return c.GetGameInfoAndUserProgressContext(context.Background(), params)
+}
+
+// GetGameInfoAndUserProgressContext get metadata about a game as well as a user's progress on that game.
+func (c *Client) GetGameInfoAndUserProgressContext(ctx context.Context, params models.GetGameInfoAndUserProgressParameters) (*models.GetGameInfoAndUserProgress, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -110,7 +164,7 @@ func (c *Client) GetGameInfoAndUserProgress(params models.GetGameInfoAndUserProg
 		}
 		details = append(details, raHttp.A(a))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -122,14 +176,31 @@ func (c *Client) GetGameInfoAndUserProgress(params models.GetGameInfoAndUserProg
 }
 
 // GetUserCompletionProgress get metadata about all the user's played games and any awards associated with them.
+//
+// GetUserCompletionProgress uses context.Background internally; to specify the context, use GetUserCompletionProgressContext.
 func (c *Client) GetUserCompletionProgress(params models.GetUserCompletionProgressParameters) (*models.GetUserCompletionProgress, error) {
-	r, err := c.do(
+	return c.GetUserCompletionProgressContext(context.Background(), params)
+}
+
+// GetUserCompletionProgressContext get metadata about all the user's played games and any awards associated with them.
+func (c *Client) GetUserCompletionProgressContext(ctx context.Context, params models.GetUserCompletionProgressParameters) (*models.GetUserCompletionProgress, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
+	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserCompletionProgress.php"),
 		raHttp.Y(c.APISecret),
 		raHttp.U(params.Username),
-	)
+	}
+	if params.Count != nil {
+		details = append(details, raHttp.C(*params.Count))
+	}
+	if params.Offset != nil {
+		details = append(details, raHttp.O(*params.Offset))
+	}
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -141,8 +212,19 @@ func (c *Client) GetUserCompletionProgress(params models.GetUserCompletionProgre
 }
 
 // GetUserAwards get a list of a user's site awards/badges.
+//
+// GetUserAwards uses context.Background internally; to specify the context, use GetUserAwardsContext.
 func (c *Client) GetUserAwards(params models.GetUserAwardsParameters) (*models.GetUserAwards, error) {
+	return c.GetUserAwardsContext(context.Background(), params)
+}
+
+// GetUserAwardsContext get a list of a user's site awards/badges.
+func (c *Client) GetUserAwardsContext(ctx context.Context, params models.GetUserAwardsParameters) (*models.GetUserAwards, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserAwards.php"),
@@ -160,8 +242,19 @@ func (c *Client) GetUserAwards(params models.GetUserAwardsParameters) (*models.G
 }
 
 // GetUserClaims get a list of set development claims made over the lifetime of a user.
+//
+// GetUserClaims uses context.Background internally; to specify the context, use GetUserClaimsContext.
 func (c *Client) GetUserClaims(params models.GetUserClaimsParameters) ([]models.GetUserClaims, error) {
+	return c.GetUserClaimsContext(context.Background(), params)
+}
+
+// GetUserClaimsContext get a list of set development claims made over the lifetime of a user.
+func (c *Client) GetUserClaimsContext(ctx context.Context, params models.GetUserClaimsParameters) ([]models.GetUserClaims, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserClaims.php"),
@@ -179,8 +272,19 @@ func (c *Client) GetUserClaims(params models.GetUserClaimsParameters) ([]models.
 }
 
 // GetUserGameRankAndScore get metadata about how a user has performed on a given game.
+//
+// GetUserGameRankAndScore uses context.Background internally; to specify the context, use GetUserGameRankAndScoreContext.
 func (c *Client) GetUserGameRankAndScore(params models.GetUserGameRankAndScoreParameters) ([]models.GetUserGameRankAndScore, error) {
+	return c.GetUserGameRankAndScoreContext(context.Background(), params)
+}
+
+// GetUserGameRankAndScoreContext get metadata about how a user has performed on a given game.
+func (c *Client) GetUserGameRankAndScoreContext(ctx context.Context, params models.GetUserGameRankAndScoreParameters) ([]models.GetUserGameRankAndScore, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserGameRankAndScore.php"),
@@ -199,8 +303,19 @@ func (c *Client) GetUserGameRankAndScore(params models.GetUserGameRankAndScorePa
 }
 
 // GetUserPoints get a user's total hardcore and softcore points.
+//
+// GetUserPoints uses context.Background internally; to specify the context, use GetUserPointsContext.
 func (c *Client) GetUserPoints(params models.GetUserPointsParameters) (*models.GetUserPoints, error) {
+	return c.GetUserPointsContext(context.Background(), params)
+}
+
+// GetUserPointsContext get a user's total hardcore and softcore points.
+func (c *Client) GetUserPointsContext(ctx context.Context, params models.GetUserPointsParameters) (*models.GetUserPoints, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserPoints.php"),
@@ -218,12 +333,23 @@ func (c *Client) GetUserPoints(params models.GetUserPointsParameters) (*models.G
 }
 
 // GetUserProgress get a user's progress on a list of specified games.
+//
+// GetUserProgress uses context.Background internally; to specify the context, use GetUserProgressContext.
 func (c *Client) GetUserProgress(params models.GetUserProgressParameters) (*map[string]models.GetUserProgress, error) {
+	return c.GetUserProgressContext(context.Background(), params)
+}
+
+// GetUserProgressContext get a user's progress on a list of specified games.
+func (c *Client) GetUserProgressContext(ctx context.Context, params models.GetUserProgressParameters) (*map[string]models.GetUserProgress, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	strIDs := []string{}
 	for i := range params.GameIDs {
 		strIDs = append(strIDs, strconv.Itoa(params.GameIDs[i]))
 	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserProgress.php"),
@@ -242,7 +368,17 @@ func (c *Client) GetUserProgress(params models.GetUserProgressParameters) (*map[
 }
 
 // GetUserRecentlyPlayedGames get a list of games a user has recently played.
+//
+// GetUserRecentlyPlayedGames uses context.Background internally; to specify the context, use GetUserRecentlyPlayedGamesContext.
 func (c *Client) GetUserRecentlyPlayedGames(params models.GetUserRecentlyPlayedGamesParameters) ([]models.GetUserRecentlyPlayedGames, error) {
+	return c.GetUserRecentlyPlayedGamesContext(context.Background(), params)
+}
+
+// GetUserRecentlyPlayedGamesContext get a list of games a user has recently played.
+func (c *Client) GetUserRecentlyPlayedGamesContext(ctx context.Context, params models.GetUserRecentlyPlayedGamesParameters) ([]models.GetUserRecentlyPlayedGames, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -256,7 +392,7 @@ func (c *Client) GetUserRecentlyPlayedGames(params models.GetUserRecentlyPlayedG
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -268,7 +404,17 @@ func (c *Client) GetUserRecentlyPlayedGames(params models.GetUserRecentlyPlayedG
 }
 
 // GetUserSummary get summary information about a given user.
+//
+// GetUserSummary uses context.Background internally; to specify the context, use GetUserSummaryContext.
 func (c *Client) GetUserSummary(params models.GetUserSummaryParameters) (*models.GetUserSummary, error) {
+	return c.GetUserSummaryContext(context.Background(), params)
+}
+
+// GetUserSummaryContext get summary information about a given user.
+func (c *Client) GetUserSummaryContext(ctx context.Context, params models.GetUserSummaryParameters) (*models.GetUserSummary, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -282,7 +428,7 @@ func (c *Client) GetUserSummary(params models.GetUserSummaryParameters) (*models
 	if params.AchievementsCount != nil {
 		details = append(details, raHttp.A(*params.AchievementsCount))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -294,8 +440,19 @@ func (c *Client) GetUserSummary(params models.GetUserSummaryParameters) (*models
 }
 
 // GetUserCompletedGames gets completion metadata about the games a given user has played.
+//
+// GetUserCompletedGames uses context.Background internally; to specify the context, use GetUserCompletedGamesContext.
 func (c *Client) GetUserCompletedGames(params models.GetUserCompletedGamesParameters) ([]models.GetUserCompletedGames, error) {
+	return c.GetUserCompletedGamesContext(context.Background(), params)
+}
+
+// GetUserCompletedGamesContext gets completion metadata about the games a given user has played.
+func (c *Client) GetUserCompletedGamesContext(ctx context.Context, params models.GetUserCompletedGamesParameters) ([]models.GetUserCompletedGames, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	r, err := c.do(
+		ctx,
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
 		raHttp.Path("/API/API_GetUserCompletedGames.php"),
@@ -313,7 +470,17 @@ func (c *Client) GetUserCompletedGames(params models.GetUserCompletedGamesParame
 }
 
 // GetUserWantToPlayList gets a given user's "Want to Play Games" list.
+//
+// GetUserWantToPlayList uses context.Background internally; to specify the context, use GetUserWantToPlayListContext.
 func (c *Client) GetUserWantToPlayList(params models.GetUserWantToPlayListParameters) (*models.GetUserWantToPlayList, error) {
+	return c.GetUserWantToPlayListContext(context.Background(), params)
+}
+
+// GetUserWantToPlayListContext gets a given user's "Want to Play Games" list.
+func (c *Client) GetUserWantToPlayListContext(ctx context.Context, params models.GetUserWantToPlayListParameters) (*models.GetUserWantToPlayList, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -327,7 +494,7 @@ func (c *Client) GetUserWantToPlayList(params models.GetUserWantToPlayListParame
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -339,7 +506,17 @@ func (c *Client) GetUserWantToPlayList(params models.GetUserWantToPlayListParame
 }
 
 // GetUsersIFollow gets the caller's "Following" users list.
+//
+// GetUsersIFollow uses context.Background internally; to specify the context, use GetUsersIFollowContext.
 func (c *Client) GetUsersIFollow(params models.GetUsersIFollowParameters) (*models.GetUsersIFollow, error) {
+	return c.GetUsersIFollowContext(context.Background(), params)
+}
+
+// GetUsersIFollowContext gets the caller's "Following" users list.
+func (c *Client) GetUsersIFollowContext(ctx context.Context, params models.GetUsersIFollowParameters) (*models.GetUsersIFollow, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -352,7 +529,7 @@ func (c *Client) GetUsersIFollow(params models.GetUsersIFollowParameters) (*mode
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -363,8 +540,18 @@ func (c *Client) GetUsersIFollow(params models.GetUsersIFollowParameters) (*mode
 	return resp, nil
 }
 
-// GetUsersIFollow gets the caller's "Followers" users list.
+// GetUsersFollowingMe gets the caller's "Followers" users list.
+//
+// GetUsersFollowingMe uses context.Background internally; to specify the context, use GetUsersFollowingMeContext.
 func (c *Client) GetUsersFollowingMe(params models.GetUsersFollowingMeParameters) (*models.GetUsersFollowingMe, error) {
+	return c.GetUsersFollowingMeContext(context.Background(), params)
+}
+
+// GetUsersFollowingMeContext gets the caller's "Followers" users list.
+func (c *Client) GetUsersFollowingMeContext(ctx context.Context, params models.GetUsersFollowingMeParameters) (*models.GetUsersFollowingMe, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -377,7 +564,7 @@ func (c *Client) GetUsersFollowingMe(params models.GetUsersFollowingMeParameters
 	if params.Offset != nil {
 		details = append(details, raHttp.O(*params.Offset))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
@@ -389,7 +576,17 @@ func (c *Client) GetUsersFollowingMe(params models.GetUsersFollowingMeParameters
 }
 
 // GetUserSetRequests gets a user's list of set requests.
+//
+// GetUserSetRequests uses context.Background internally; to specify the context, use GetUserSetRequestsContext.
 func (c *Client) GetUserSetRequests(params models.GetUserSetRequestsParameters) (*models.GetUserSetRequests, error) {
+	return c.GetUserSetRequestsContext(context.Background(), params)
+}
+
+// GetUserSetRequestsContext gets a user's list of set requests.
+func (c *Client) GetUserSetRequestsContext(ctx context.Context, params models.GetUserSetRequestsParameters) (*models.GetUserSetRequests, error) {
+	if err := validateParameters(params); err != nil {
+		return nil, fmt.Errorf("validating parameters: %w", err)
+	}
 	details := []raHttp.RequestDetail{
 		raHttp.Method(http.MethodGet),
 		raHttp.UserAgent(c.UserAgent),
@@ -404,7 +601,7 @@ func (c *Client) GetUserSetRequests(params models.GetUserSetRequestsParameters)
 		}
 		details = append(details, raHttp.T(t))
 	}
-	r, err := c.do(details...)
+	r, err := c.do(ctx, details...)
 	if err != nil {
 		return nil, fmt.Errorf("calling endpoint: %w", err)
 	}
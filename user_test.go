@@ -49,7 +49,7 @@ func TestGetUserProfile(tt *testing.T) {
 			},
 			assert: func(t *testing.T, profile *models.GetUserProfile, err error) {
 				require.Nil(t, profile)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserProfile.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserProfile.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -201,7 +201,7 @@ func TestGetUserRecentAchievements(tt *testing.T) {
 			},
 			assert: func(t *testing.T, achievements []models.GetUserRecentAchievements, err error) {
 				require.Nil(t, achievements)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserRecentAchievements.php?m=20&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserRecentAchievements.php?m=20&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -359,7 +359,7 @@ func TestGetAchievementsEarnedBetween(tt *testing.T) {
 			},
 			assert: func(t *testing.T, achievements []models.GetAchievementsEarnedBetween, err error) {
 				require.Nil(t, achievements)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementsEarnedBetween.php?f=1709400423&t=1709401023&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementsEarnedBetween.php?f=1709400423&t=1709401023&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -519,7 +519,7 @@ func TestGetAchievementsEarnedOnDay(tt *testing.T) {
 			},
 			assert: func(t *testing.T, achievements []models.GetAchievementsEarnedOnDay, err error) {
 				require.Nil(t, achievements)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementsEarnedOnDay.php?d=2024-03-02&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetAchievementsEarnedOnDay.php?d=2024-03-02&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -688,7 +688,7 @@ func TestGetGameInfoAndUserProgress(tt *testing.T) {
 			},
 			assert: func(t *testing.T, gameProgress *models.GetGameInfoAndUserProgress, err error) {
 				require.Nil(t, gameProgress)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameInfoAndUserProgress.php?a=1&g=2991&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetGameInfoAndUserProgress.php?a=1&g=2991&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -909,7 +909,7 @@ func TestGetUserCompletionProgress(tt *testing.T) {
 			},
 			assert: func(t *testing.T, completionProgress *models.GetUserCompletionProgress, err error) {
 				require.Nil(t, completionProgress)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserCompletionProgress.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserCompletionProgress.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1065,7 +1065,7 @@ func TestGetUserAwards(tt *testing.T) {
 			},
 			assert: func(t *testing.T, userAwards *models.GetUserAwards, err error) {
 				require.Nil(t, userAwards)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserAwards.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserAwards.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1226,7 +1226,7 @@ func TestGetUserClaims(tt *testing.T) {
 			},
 			assert: func(t *testing.T, userClaims []models.GetUserClaims, err error) {
 				require.Nil(t, userClaims)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserClaims.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserClaims.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1386,7 +1386,7 @@ func TestGetUserGameRankAndScore(tt *testing.T) {
 			},
 			assert: func(t *testing.T, userGameRankScore []models.GetUserGameRankAndScore, err error) {
 				require.Nil(t, userGameRankScore)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserGameRankAndScore.php?g=10&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserGameRankAndScore.php?g=10&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1515,7 +1515,7 @@ func TestGetUserPoints(tt *testing.T) {
 			},
 			assert: func(t *testing.T, points *models.GetUserPoints, err error) {
 				require.Nil(t, points)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserPoints.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserPoints.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1634,7 +1634,7 @@ func TestGetUserProgress(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *map[string]models.GetUserProgress, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserProgress.php?i=1%2C2%2C5352&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserProgress.php?i=1%2C2%2C5352&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -1812,7 +1812,7 @@ func TestGetUserRecentlyPlayedGames(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetUserRecentlyPlayedGames, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserRecentlyPlayedGames.php?c=10&o=0&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserRecentlyPlayedGames.php?c=10&o=0&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2012,7 +2012,7 @@ func TestGetUserSummary(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUserSummary, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserSummary.php?a=5&g=10&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserSummary.php?a=5&g=10&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2258,7 +2258,7 @@ func TestGetUserCompletedGames(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp []models.GetUserCompletedGames, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserCompletedGames.php?u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserCompletedGames.php?u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2396,7 +2396,7 @@ func TestGetUserWantToPlayList(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUserWantToPlayList, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserWantToPlayList.php?c=10&o=23&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserWantToPlayList.php?c=10&o=23&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2539,7 +2539,7 @@ func TestGetUsersIFollow(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUsersIFollow, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUsersIFollow.php?c=10&o=23&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUsersIFollow.php?c=10&o=23&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2675,7 +2675,7 @@ func TestGetUsersFollowingMe(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUsersFollowingMe, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUsersFollowingMe.php?c=10&o=23&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUsersFollowingMe.php?c=10&o=23&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2810,7 +2810,7 @@ func TestGetUserSetRequests(tt *testing.T) {
 			},
 			assert: func(t *testing.T, resp *models.GetUserSetRequests, err error) {
 				require.Nil(t, resp)
-				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserSetRequests.php?t=1&u=Test&y=some_secret\": unsupported protocol scheme \"\"")
+				require.EqualError(t, err, "calling endpoint: Get \"/API/API_GetUserSetRequests.php?t=1&u=Test&y=REDACTED\": unsupported protocol scheme \"\"")
 			},
 		},
 		{
@@ -2909,3 +2909,31 @@ func TestGetUserSetRequests(tt *testing.T) {
 		})
 	}
 }
+
+func TestGetUserCompletionProgressQuery(tt *testing.T) {
+	count, offset := 500, 100
+	tests := []struct {
+		name     string
+		params   models.GetUserCompletionProgressParameters
+		expected string
+	}{
+		{
+			name:     "required only",
+			params:   models.GetUserCompletionProgressParameters{Username: "Test"},
+			expected: "u=Test",
+		},
+		{
+			name:     "page",
+			params:   models.GetUserCompletionProgressParameters{Username: "Test", Count: &count, Offset: &offset},
+			expected: "c=500&o=100&u=Test",
+		},
+	}
+	for _, test := range tests {
+		tt.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, capturedQuery(t, func(client *retroachievements.Client) error {
+				_, err := client.GetUserCompletionProgress(test.params)
+				return err
+			}))
+		})
+	}
+}